@@ -5,34 +5,220 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/arayofcode/footprint/internal/app"
+	"github.com/arayofcode/footprint/internal/render/card"
+	"github.com/arayofcode/footprint/internal/scoring"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "explain" {
+		explain(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "rescore" {
+		rescore(os.Args[2:])
+		return
+	}
+
 	var (
 		username   string
+		org        string
+		team       string
+		roster     string
+		leaderSize int
+		community  string
+		since      string
+		until      string
 		minStars   int
 		outputDir  string
 		timeout    time.Duration
 		enableCard bool
+		milestones bool
+		languages  bool
+		cardTrend  bool
+		history    bool
+		digest     bool
+		resolves   bool
+
+		scoringConfig    string
+		baseScores       string
+		reactionWeights  string
+		reactionBonusCap *float64
+		recencyHalfLife  *float64
+		popularityModel  string
+		repoMetrics      string
+		starsAtEvent     bool
+		starCache        string
+		trivialPRs       bool
+		decayStrategy    string
+
+		triage     bool
+		advisories bool
+		releases   bool
+
+		privateStats bool
+		privateScore bool
 	)
 	flag.StringVar(&username, "username", "", "GitHub username (defaults to GITHUB_ACTOR)")
+	flag.StringVar(&org, "org", "", "Organization login: build one footprint for all its members")
+	flag.StringVar(&team, "team", "", "Team slug within -org: only include the team's members")
+	flag.StringVar(&roster, "roster", "", "File with one GitHub username per line to aggregate instead of org members")
+	flag.IntVar(&leaderSize, "leaderboard-size", card.DefaultLeaderboardSize, "Members ranked on the org leaderboard card")
+	flag.StringVar(&community, "community", "", "Report who contributes to your own repos: \"all\" or a comma-separated list of repo names")
+	flag.StringVar(&since, "since", "", "Only count contributions from this date: YYYY, YYYY-MM, YYYY-MM-DD, RFC 3339, or a period ago like 90d, 12m")
+	flag.StringVar(&until, "until", "", "Only count contributions up to and including this date, in the same formats as -since")
 	flag.IntVar(&minStars, "min-stars", 0, "Minimum stars for owned projects")
 	flag.StringVar(&outputDir, "output", "dist", "Output directory")
 	flag.DurationVar(&timeout, "timeout", 300*time.Second, "Timeout for GitHub API operations")
 	flag.BoolVar(&enableCard, "card", true, "Generate SVG card")
+	flag.BoolVar(&milestones, "card-milestones", false, "Add a milestones section to the extended cards")
+	flag.BoolVar(&languages, "card-languages", false, "Add a score-by-language section to the extended cards")
+	flag.BoolVar(&cardTrend, "card-trend", false, "Mark card stats with their change since the history baseline (requires -history)")
+	flag.BoolVar(&digest, "digest", false, "Write digest.md and digest.json with what changed since the previous run")
+	flag.BoolVar(&history, "history", false, "Append a snapshot to history.jsonl in the output directory and report the trend since earlier runs")
+	flag.BoolVar(&resolves, "resolves-issues-bonus", false, "Score merged PRs higher for each issue they close")
+	flag.StringVar(&scoringConfig, "scoring-config", "", "JSON file overriding the default scoring weights")
+	flag.StringVar(&baseScores, "base-scores", "", "Per-type base scores, e.g. REVIEW=6,PR=8 (overrides -scoring-config)")
+	flag.StringVar(&reactionWeights, "reaction-weights", "", "Per-reaction score weights, e.g. THUMBS_UP=0.5,HEART=1,ROCKET=1 (default: reactions not scored)")
+	flag.BoolVar(&triage, "triage", false, "Count labeling, closing and other triage actions in repos you can triage (needs your own token; only recently updated issues and PRs are checked)")
+	flag.BoolVar(&advisories, "security-advisories", false, "Credit published security advisories that name you, checked in every repo you contribute to")
+	flag.BoolVar(&releases, "releases", false, "Credit releases you authored in repos you don't own, walking the latest 500 releases of each repo you have a merged PR in")
+	flag.BoolVar(&privateStats, "private-stats", false, "Show aggregate-only private contribution totals on the card and in report.json")
+	flag.BoolVar(&privateScore, "private-score", false, "Count private contributions towards the impact score (requires -private-stats)")
+	optionalFloatVar(flag.CommandLine, &reactionBonusCap, "reaction-bonus-cap", fmt.Sprintf("Maximum reaction `bonus` per contribution (default from -scoring-config, or %g)", scoring.DefaultReactionBonusCap))
+	optionalFloatVar(flag.CommandLine, &recencyHalfLife, "recency-half-life", "Halve a contribution's score for every this many `days` of age, 0 to turn it off (default from -scoring-config, or off)")
+	flag.StringVar(&popularityModel, "popularity-model", "", "Repo popularity model: log, percentile, dependents or flat (default from -scoring-config, or log)")
+	flag.StringVar(&repoMetrics, "repo-metrics", "", "JSON file of dependents and monthly downloads per repo, for the dependents popularity model")
+	flag.BoolVar(&starsAtEvent, "stars-at-event", false, "Measure popularity by each repo's estimated stars when the contribution was made, sampled from stargazer history")
+	flag.BoolVar(&trivialPRs, "trivial-prs", false, "Score typo fixes, docs-only and tiny PRs at a reduced base (tuned by trivialPR in -scoring-config)")
+	flag.StringVar(&decayStrategy, "decay-strategy", "", "How repeated contributions lose value: hyperbolic, exponential, budget or window (default from -scoring-config, or hyperbolic)")
+	flag.StringVar(&starCache, "star-cache", "", "File caching sampled stargazer history between runs (default in the user cache directory)")
 	flag.Parse()
 
 	if err := app.RunCLI(context.Background(), app.CLIConfig{
-		Username:   username,
+		Username: username,
+		Org:      org,
+		Team:     team,
+		Roster:   roster,
+
+		LeaderboardSize: leaderSize,
+		Community:       community,
+
+		Since: since,
+		Until: until,
+
 		MinStars:   minStars,
 		OutputDir:  outputDir,
 		Timeout:    timeout,
 		EnableCard: enableCard,
+		Milestones: milestones,
+		Languages:  languages,
+		CardTrend:  cardTrend,
+		History:    history,
+		Digest:     digest,
+
+		ScoringConfig:       scoringConfig,
+		BaseScores:          baseScores,
+		ResolvesIssuesBonus: resolves,
+		ReactionWeights:     reactionWeights,
+		ReactionBonusCap:    reactionBonusCap,
+		RecencyHalfLife:     recencyHalfLife,
+		PopularityModel:     popularityModel,
+		RepoMetrics:         repoMetrics,
+		StarsAtEvent:        starsAtEvent,
+		StarCache:           starCache,
+		TrivialPRs:          trivialPRs,
+		DecayStrategy:       decayStrategy,
+
+		Triage:     triage,
+		Advisories: advisories,
+		Releases:   releases,
+
+		PrivateStats: privateStats,
+		PrivateScore: privateScore,
 	}); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
 }
+
+// explain runs "footprint explain [-report path] <repo|url>".
+func explain(args []string) {
+	fs := flag.NewFlagSet("explain", flag.ExitOnError)
+	reportPath := fs.String("report", "dist/report.json", "report.json to explain")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: footprint explain [-report path] <repo|url>\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	if err := app.RunExplain(os.Stdout, *reportPath, fs.Arg(0)); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+// rescore runs "footprint rescore [flags]" over a saved events.json.
+func rescore(args []string) {
+	var cfg app.CLIConfig
+	fs := flag.NewFlagSet("rescore", flag.ExitOnError)
+	eventsPath := fs.String("events", "dist/events.json", "events.json written by a previous run")
+	fs.StringVar(&cfg.OutputDir, "output", "dist/rescore", "Output directory")
+	fs.IntVar(&cfg.MinStars, "min-stars", 0, "Minimum stars for owned projects shown on the card")
+	fs.BoolVar(&cfg.EnableCard, "card", true, "Generate SVG card")
+	fs.BoolVar(&cfg.Milestones, "card-milestones", false, "Add a milestones section to the extended cards")
+	fs.BoolVar(&cfg.Languages, "card-languages", false, "Add a score-by-language section to the extended cards")
+	fs.StringVar(&cfg.ScoringConfig, "scoring-config", "", "JSON file overriding the default scoring weights")
+	fs.StringVar(&cfg.BaseScores, "base-scores", "", "Per-type base scores, e.g. REVIEW=6,PR=8 (overrides -scoring-config)")
+	fs.BoolVar(&cfg.ResolvesIssuesBonus, "resolves-issues-bonus", false, "Score merged PRs higher for each issue they close")
+	fs.StringVar(&cfg.ReactionWeights, "reaction-weights", "", "Per-reaction score weights, e.g. THUMBS_UP=0.5,HEART=1,ROCKET=1")
+	optionalFloatVar(fs, &cfg.ReactionBonusCap, "reaction-bonus-cap", "Maximum reaction `bonus` per contribution (default from -scoring-config)")
+	optionalFloatVar(fs, &cfg.RecencyHalfLife, "recency-half-life", "Halve a contribution's score for every this many `days` of age, 0 to turn it off (default from -scoring-config, or off)")
+	fs.StringVar(&cfg.PopularityModel, "popularity-model", "", "Repo popularity model: log, percentile, dependents or flat (default from -scoring-config, or log)")
+	fs.BoolVar(&cfg.TrivialPRs, "trivial-prs", false, "Score typo fixes, docs-only and tiny PRs at a reduced base (tuned by trivialPR in -scoring-config)")
+	fs.StringVar(&cfg.DecayStrategy, "decay-strategy", "", "How repeated contributions lose value: hyperbolic, exponential, budget or window (default from -scoring-config, or hyperbolic)")
+	fs.BoolVar(&cfg.PrivateScore, "private-score", false, "Count private contributions towards the impact score")
+	fs.Parse(args)
+
+	if err := app.RunRescore(context.Background(), cfg, *eventsPath); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+// optionalFloat is a float flag that stays nil until it is set, so 0 can be
+// told apart from "not given". An empty value leaves it unset.
+type optionalFloat struct {
+	p **float64
+}
+
+func optionalFloatVar(fs *flag.FlagSet, p **float64, name, usage string) {
+	fs.Var(optionalFloat{p}, name, usage)
+}
+
+func (f optionalFloat) String() string {
+	if f.p == nil || *f.p == nil {
+		return ""
+	}
+	return strconv.FormatFloat(**f.p, 'g', -1, 64)
+}
+
+func (f optionalFloat) Set(s string) error {
+	if s == "" {
+		*f.p = nil
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*f.p = &v
+	return nil
+}
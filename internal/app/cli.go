@@ -2,10 +2,17 @@ package app
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
+	"maps"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/arayofcode/footprint/internal/domain"
 	"github.com/arayofcode/footprint/internal/github"
 	"github.com/arayofcode/footprint/internal/output"
 	"github.com/arayofcode/footprint/internal/render/card"
@@ -19,11 +26,80 @@ import (
 type CLIConfig struct {
 	Username string
 
+	// Org mode: aggregate every member of Org (or Org/Team), or of a roster
+	// file with one username per line. Username is ignored in org mode.
+	Org    string
+	Team   string
+	Roster string
+
+	LeaderboardSize int // Members ranked on the org leaderboard card
+
+	// Community mode: report who contributes to Username's own repos.
+	// "all" covers every owned project with at least MinStars stars;
+	// otherwise a comma-separated list of repo names.
+	Community string
+
+	// Since and Until limit contributions to a period, see
+	// domain.ParseTimeWindow. Both empty means all-time.
+	Since string
+	Until string
+
 	MinStars  int
 	OutputDir string
 	Timeout   time.Duration
 
 	EnableCard bool
+	Milestones bool // Show the milestones section on extended cards
+	Languages  bool // Show the score-by-language section on extended cards
+	CardTrend  bool // Mark card stats with their change since the history baseline
+
+	// Digest writes digest.md and digest.json, what changed since the
+	// previous run: the report.json already in OutputDir, or else the latest
+	// history snapshot.
+	Digest bool
+
+	// History appends each personal run to history.jsonl in OutputDir and
+	// reports the trend since earlier runs.
+	History bool
+
+	// Scoring weights: ScoringConfig is a JSON file over the defaults; the
+	// fields below override it when set.
+	ScoringConfig       string
+	BaseScores          string // TYPE=score pairs, see scoring.ParseBaseScores
+	ResolvesIssuesBonus bool
+	ReactionWeights     string   // CONTENT=weight pairs, see scoring.ParseReactionWeights
+	ReactionBonusCap    *float64 // nil keeps the config file's value
+	RecencyHalfLife     *float64 // Days, 0 turning recency off; nil keeps the config file's value
+	PopularityModel     string   // See domain.PopularityModels; empty keeps the config file's value
+	TrivialPRs          bool     // Enable trivial PR detection, see domain.TrivialPRConfig
+	DecayStrategy       string   // See domain.DecayStrategies; empty keeps the config file's value
+
+	// RepoMetrics is a JSON file of dependents and downloads per repo, e.g.
+	// {"owner/name": {"dependents": 120, "downloads": 50000}}, used by the
+	// dependents popularity model.
+	RepoMetrics string
+
+	// StarsAtEvent measures each event's popularity by its repo's estimated
+	// star count when the event was created, sampled from stargazer history
+	// and cached in StarCache (defaultStarCachePath when empty).
+	StarsAtEvent bool
+	StarCache    string
+
+	// Triage walks the timelines of recently updated issues and PRs in repos
+	// where the token's owner has triage rights. It needs a token of
+	// Username's own, and misses triage on items not updated recently.
+	Triage bool
+
+	// Advisories checks every repo Username contributes to for published
+	// security advisories that credit them.
+	Advisories bool
+
+	// Releases walks the release history of non-owned repos where Username
+	// has a merged PR, for releases they authored.
+	Releases bool
+
+	PrivateStats bool // Fetch aggregate-only private contribution totals
+	PrivateScore bool // Include private totals in the impact score
 }
 
 func RunCLI(ctx context.Context, cfg CLIConfig) error {
@@ -31,11 +107,19 @@ func RunCLI(ctx context.Context, cfg CLIConfig) error {
 		ctx = context.Background()
 	}
 
+	orgMode := cfg.Org != "" || cfg.Roster != ""
+	if cfg.Team != "" && cfg.Org == "" {
+		return fmt.Errorf("team requires an org")
+	}
+	if orgMode && cfg.Community != "" {
+		return fmt.Errorf("community mode cannot be combined with org mode")
+	}
+
 	username := cfg.Username
 	if username == "" {
 		username = os.Getenv("GITHUB_ACTOR")
 	}
-	if username == "" {
+	if username == "" && !orgMode {
 		return fmt.Errorf("username is required (set CLIConfig.Username or GITHUB_ACTOR)")
 	}
 
@@ -44,8 +128,31 @@ func RunCLI(ctx context.Context, cfg CLIConfig) error {
 		return fmt.Errorf("GITHUB_TOKEN is required for GitHub API access")
 	}
 
+	if cfg.PrivateScore && !cfg.PrivateStats {
+		return fmt.Errorf("private contribution scoring requires private stats to be enabled")
+	}
+
+	if cfg.CardTrend && !cfg.History {
+		return fmt.Errorf("card trends require history to be enabled")
+	}
+
 	minStars := max(cfg.MinStars, 0)
 
+	scoringConfig, err := loadScoringConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	window, err := domain.ParseTimeWindow(cfg.Since, cfg.Until, time.Now())
+	if err != nil {
+		return fmt.Errorf("invalid time window: %w", err)
+	}
+
+	metrics, err := readRepoMetrics(cfg.RepoMetrics)
+	if err != nil {
+		return err
+	}
+
 	outputDir := cfg.OutputDir
 	if outputDir == "" {
 		outputDir = "dist"
@@ -61,24 +168,74 @@ func RunCLI(ctx context.Context, cfg CLIConfig) error {
 
 	src := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
 	httpClient := oauth2.NewClient(ctx, src)
+	httpClient.Transport = github.NewRateLimitTransport(httpClient.Transport)
 	ghClient := githubv4.NewClient(httpClient)
 
-	client := github.NewClient(ghClient)
+	client := github.NewClient(ghClient, httpClient, github.ClientOptions{
+		PRFiles:    scoringConfig.TrivialPR.Enabled,
+		Triage:     cfg.Triage,
+		Advisories: cfg.Advisories,
+		Releases:   cfg.Releases,
+	})
 	writer := output.NewFileSystemWriter(outputDir)
 
+	var starHistory domain.StarHistorySource
+	if cfg.StarsAtEvent {
+		path := cfg.StarCache
+		if path == "" {
+			path = defaultStarCachePath()
+		}
+		cache, err := newStarHistoryCache(github.NewStarHistoryFetcher(httpClient), path)
+		if err != nil {
+			return err
+		}
+		starHistory = cache
+	}
+
+	calculator := scoring.NewCalculator(scoringConfig)
+
+	if orgMode {
+		return runOrg(ctx, cfg, window, metrics, starHistory, client, calculator, writer)
+	}
+
+	if cfg.Community != "" {
+		return runCommunity(ctx, cfg, window, metrics, starHistory, username, minStars, client, calculator, writer)
+	}
+
 	gen := &Generator{
 		Fetcher:         client,
 		Projects:        client,
-		Scorer:          scoring.NewCalculator(),
-		ReportRenderer:  report.Renderer{},
-		SummaryRenderer: summary.Renderer{},
+		Scorer:          calculator,
+		ReportRenderer:  report.Renderer{Scoring: &scoringConfig, Window: window},
+		SummaryRenderer: summary.Renderer{Window: window},
 		Writer:          writer,
 		Actions:         github.NewActions(),
 		MinStars:        minStars,
+		Window:          window,
+		RepoMetrics:     metrics,
+		StarHistory:     starHistory,
+	}
+
+	if cfg.History {
+		gen.History = output.NewHistoryFile(filepath.Join(outputDir, output.HistoryFilename))
+	}
+
+	if cfg.Digest {
+		previous, err := readPreviousReport(filepath.Join(outputDir, "report.json"))
+		if err != nil {
+			return err
+		}
+		gen.DigestRenderer = summary.Renderer{Window: window}
+		gen.Previous = previous
+	}
+
+	if cfg.PrivateStats {
+		gen.Private = client
+		gen.ScorePrivate = cfg.PrivateScore
 	}
 
 	if cfg.EnableCard {
-		gen.CardRenderer = card.Renderer{MinDisplayStars: minStars}
+		gen.CardRenderer = card.Renderer{MinDisplayStars: minStars, ShowMilestones: cfg.Milestones, ShowLanguages: cfg.Languages, ShowTrend: cfg.CardTrend, Window: window}
 	}
 
 	if err := gen.Run(ctx, username); err != nil {
@@ -87,3 +244,178 @@ func RunCLI(ctx context.Context, cfg CLIConfig) error {
 
 	return nil
 }
+
+func runOrg(ctx context.Context, cfg CLIConfig, window domain.TimeWindow, metrics map[string]domain.RepoMetrics, starHistory domain.StarHistorySource, client *github.Client, calculator *scoring.Calculator, writer domain.OutputWriter) error {
+	scoringConfig := calculator.Config()
+	scope := domain.OrgScope{Org: cfg.Org, Team: cfg.Team, Name: cfg.Org}
+	if cfg.Team != "" {
+		scope.Name = cfg.Org + "/" + cfg.Team
+	}
+
+	var roster []string
+	if cfg.Roster != "" {
+		var err error
+		roster, err = readRoster(cfg.Roster)
+		if err != nil {
+			return err
+		}
+		if scope.Name == "" {
+			scope.Name = strings.TrimSuffix(filepath.Base(cfg.Roster), filepath.Ext(cfg.Roster))
+		}
+	}
+
+	gen := &OrgGenerator{
+		Members:         client,
+		Fetcher:         client,
+		Scorer:          calculator,
+		ReportRenderer:  report.Renderer{Scoring: &scoringConfig, Window: window},
+		SummaryRenderer: summary.Renderer{Window: window},
+		Writer:          writer,
+		Actions:         github.NewActions(),
+		Window:          window,
+		RepoMetrics:     metrics,
+		StarHistory:     starHistory,
+	}
+	if cfg.EnableCard {
+		size := cfg.LeaderboardSize
+		if size <= 0 {
+			size = card.DefaultLeaderboardSize
+		}
+		renderer := card.Renderer{LeaderboardSize: size, Window: window}
+		gen.CardRenderer = renderer
+		gen.Leaderboard = renderer
+		gen.LeaderboardSize = size
+	}
+
+	if err := gen.Run(ctx, scope, roster); err != nil {
+		return fmt.Errorf("org footprint failed: %w", err)
+	}
+
+	return nil
+}
+
+func runCommunity(ctx context.Context, cfg CLIConfig, window domain.TimeWindow, metrics map[string]domain.RepoMetrics, starHistory domain.StarHistorySource, owner string, minStars int, client *github.Client, calculator *scoring.Calculator, writer domain.OutputWriter) error {
+	scoringConfig := calculator.Config()
+	var repos []string
+	if cfg.Community != "all" {
+		for repo := range strings.SplitSeq(cfg.Community, ",") {
+			if repo = strings.TrimSpace(repo); repo != "" {
+				repos = append(repos, repo)
+			}
+		}
+	}
+
+	gen := &CommunityGenerator{
+		Projects:        client,
+		Activity:        client,
+		Scorer:          calculator,
+		ReportRenderer:  report.Renderer{Scoring: &scoringConfig, Window: window},
+		SummaryRenderer: summary.Renderer{Window: window},
+		Writer:          writer,
+		Actions:         github.NewActions(),
+		MinStars:        minStars,
+		Window:          window,
+		RepoMetrics:     metrics,
+		StarHistory:     starHistory,
+	}
+	if cfg.EnableCard {
+		gen.CardRenderer = card.Renderer{Window: window}
+	}
+
+	if err := gen.Run(ctx, owner, repos); err != nil {
+		return fmt.Errorf("community report failed: %w", err)
+	}
+
+	return nil
+}
+
+// readRepoMetrics reads a -repo-metrics file. Repo names are matched
+// case-insensitively, so they are stored lowercased.
+func readRepoMetrics(path string) (map[string]domain.RepoMetrics, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading repo metrics: %w", err)
+	}
+	var raw map[string]domain.RepoMetrics
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing repo metrics %s: %w", path, err)
+	}
+	metrics := make(map[string]domain.RepoMetrics, len(raw))
+	for repo, m := range raw {
+		if m.Dependents < 0 || m.Downloads < 0 {
+			return nil, fmt.Errorf("repo metrics for %s must not be negative", repo)
+		}
+		metrics[strings.ToLower(repo)] = m
+	}
+	return metrics, nil
+}
+
+// readPreviousReport reads the digest baseline from the report.json a
+// previous run left at path. A missing file means there is none.
+func readPreviousReport(path string) (*domain.Baseline, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading previous report: %w", err)
+	}
+	baseline, err := report.ReadBaseline(data)
+	if err != nil {
+		return nil, fmt.Errorf("reading previous report %s: %w", path, err)
+	}
+	return &baseline, nil
+}
+
+// loadScoringConfig builds the effective scoring weights: defaults, then the
+// config file, then individual flags.
+func loadScoringConfig(cfg CLIConfig) (domain.ScoringConfig, error) {
+	config := scoring.DefaultConfig()
+	if cfg.ScoringConfig != "" {
+		var err error
+		config, err = scoring.LoadConfig(cfg.ScoringConfig)
+		if err != nil {
+			return domain.ScoringConfig{}, err
+		}
+	}
+
+	baseScores, err := scoring.ParseBaseScores(cfg.BaseScores)
+	if err != nil {
+		return domain.ScoringConfig{}, fmt.Errorf("invalid base scores: %w", err)
+	}
+	maps.Copy(config.BaseScores, baseScores)
+
+	reactionWeights, err := scoring.ParseReactionWeights(cfg.ReactionWeights)
+	if err != nil {
+		return domain.ScoringConfig{}, fmt.Errorf("invalid reaction weights: %w", err)
+	}
+	if len(reactionWeights) > 0 {
+		config.ReactionWeights = reactionWeights
+	}
+	if cfg.ResolvesIssuesBonus {
+		config.ResolvesIssuesBonus = true
+	}
+	if cfg.ReactionBonusCap != nil {
+		config.ReactionBonusCap = *cfg.ReactionBonusCap
+	}
+	if cfg.RecencyHalfLife != nil {
+		config.RecencyHalfLifeDays = *cfg.RecencyHalfLife
+	}
+	if cfg.TrivialPRs {
+		config.TrivialPR.Enabled = true
+	}
+	if cfg.DecayStrategy != "" {
+		config.DecayStrategy = domain.DecayStrategy(strings.ToLower(cfg.DecayStrategy))
+	}
+	if cfg.PopularityModel != "" {
+		config.PopularityModel = domain.PopularityModel(strings.ToLower(cfg.PopularityModel))
+	}
+
+	if err := scoring.ValidateConfig(config); err != nil {
+		return domain.ScoringConfig{}, fmt.Errorf("invalid scoring config: %w", err)
+	}
+	return config, nil
+}
@@ -2,8 +2,12 @@ package app
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/arayofcode/footprint/internal/domain"
 )
 
 func TestRunCLI_MissingUsername(t *testing.T) {
@@ -36,3 +40,199 @@ func TestRunCLI_MissingToken(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestRunCLI_PrivateScoreRequiresPrivateStats(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "token")
+
+	err := RunCLI(context.Background(), CLIConfig{
+		Username:     "ray",
+		PrivateScore: true,
+	})
+
+	if err == nil {
+		t.Fatalf("expected error for private score without private stats")
+	}
+	if !strings.Contains(err.Error(), "requires private stats") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRunCLI_CardTrendRequiresHistory(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "token")
+
+	err := RunCLI(context.Background(), CLIConfig{
+		Username:  "ray",
+		CardTrend: true,
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "require history") {
+		t.Fatalf("expected error for card trends without history, got %v", err)
+	}
+}
+
+func TestRunCLI_CommunityRejectsOrgMode(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "token")
+
+	err := RunCLI(context.Background(), CLIConfig{
+		Org:       "acme",
+		Community: "all",
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "cannot be combined") {
+		t.Fatalf("expected community and org mode to be rejected, got %v", err)
+	}
+}
+
+func TestRunCLI_RejectsInvalidBaseScores(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "token")
+
+	err := RunCLI(context.Background(), CLIConfig{
+		Username:   "ray",
+		BaseScores: "REVIEW=-1",
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "invalid scoring config") {
+		t.Fatalf("expected negative base score to be rejected, got %v", err)
+	}
+}
+
+func TestRunCLI_RejectsInvalidWindow(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "token")
+
+	err := RunCLI(context.Background(), CLIConfig{
+		Username: "ray",
+		Since:    "2025",
+		Until:    "2024",
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "invalid time window") {
+		t.Fatalf("expected an empty window to be rejected, got %v", err)
+	}
+}
+
+func TestRunCLI_RejectsUnknownPopularityModel(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "token")
+
+	err := RunCLI(context.Background(), CLIConfig{
+		Username:        "ray",
+		PopularityModel: "stars",
+	})
+
+	if err == nil || !strings.Contains(err.Error(), `unknown model "stars"`) {
+		t.Fatalf("expected unknown popularity model to be rejected, got %v", err)
+	}
+}
+
+func TestRunCLI_RejectsNegativeReactionBonusCap(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "token")
+	reactionBonusCap := -5.0
+
+	err := RunCLI(context.Background(), CLIConfig{
+		Username:         "ray",
+		ReactionBonusCap: &reactionBonusCap,
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "reactionBonusCap") {
+		t.Fatalf("expected a negative reaction bonus cap to be rejected, got %v", err)
+	}
+}
+
+func TestLoadScoringConfig_ZeroReactionBonusCapTurnsBonusOff(t *testing.T) {
+	reactionBonusCap := 0.0
+
+	config, err := loadScoringConfig(CLIConfig{ReactionBonusCap: &reactionBonusCap})
+	if err != nil {
+		t.Fatalf("loading config failed: %v", err)
+	}
+	if config.ReactionBonusCap != 0 {
+		t.Errorf("expected the cap flag to override the default, got %g", config.ReactionBonusCap)
+	}
+}
+
+func TestRunCLI_RejectsNegativeRecencyHalfLife(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "token")
+	halfLife := -30.0
+
+	err := RunCLI(context.Background(), CLIConfig{
+		Username:        "ray",
+		RecencyHalfLife: &halfLife,
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "recencyHalfLifeDays") {
+		t.Fatalf("expected a negative half-life to be rejected, got %v", err)
+	}
+}
+
+func TestLoadScoringConfig_ZeroRecencyHalfLifeOverridesConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scoring.json")
+	if err := os.WriteFile(path, []byte(`{"recencyHalfLifeDays": 180}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	halfLife := 0.0
+
+	config, err := loadScoringConfig(CLIConfig{ScoringConfig: path, RecencyHalfLife: &halfLife})
+	if err != nil {
+		t.Fatalf("loading config failed: %v", err)
+	}
+	if config.RecencyHalfLifeDays != 0 {
+		t.Errorf("expected -recency-half-life=0 to turn recency off, got %g days", config.RecencyHalfLifeDays)
+	}
+
+	config, err = loadScoringConfig(CLIConfig{ScoringConfig: path})
+	if err != nil {
+		t.Fatalf("loading config failed: %v", err)
+	}
+	if config.RecencyHalfLifeDays != 180 {
+		t.Errorf("expected an unset flag to keep the config file's half-life, got %g days", config.RecencyHalfLifeDays)
+	}
+}
+
+func TestReadRepoMetrics(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	if err := os.WriteFile(path, []byte(`{"Owner/Lib": {"dependents": 12, "downloads": 3400}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	metrics, err := readRepoMetrics(path)
+	if err != nil {
+		t.Fatalf("readRepoMetrics: %v", err)
+	}
+	if metrics["owner/lib"] != (domain.RepoMetrics{Dependents: 12, Downloads: 3400}) {
+		t.Errorf("expected metrics keyed by lowercased repo, got %+v", metrics)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"owner/lib": {"dependents": -1}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readRepoMetrics(path); err == nil {
+		t.Errorf("expected negative metrics to be rejected")
+	}
+}
+
+func TestReadPreviousReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	baseline, err := readPreviousReport(path)
+	if err != nil || baseline != nil {
+		t.Fatalf("expected no baseline without a previous report, got %+v, %v", baseline, err)
+	}
+
+	report := `{"generatedAt": "2026-03-01T00:00:00Z", "totals": {"recent": 9}, "topRepos": [{"repo": "a/b", "impactScore": 9}]}`
+	if err := os.WriteFile(path, []byte(report), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	baseline, err = readPreviousReport(path)
+	if err != nil {
+		t.Fatalf("readPreviousReport: %v", err)
+	}
+	if baseline == nil || baseline.Score != 9 || baseline.Repos["a/b"] != 9 {
+		t.Errorf("expected the previous report's totals and repos, got %+v", baseline)
+	}
+
+	if err := os.WriteFile(path, []byte("not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readPreviousReport(path); err == nil {
+		t.Errorf("expected a malformed report to be rejected")
+	}
+}
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/arayofcode/footprint/internal/github"
+	"github.com/arayofcode/footprint/internal/logic"
+	"github.com/arayofcode/footprint/internal/render/assets"
+)
+
+// CommunityGenerator reports who contributes to a maintainer's own repos.
+type CommunityGenerator struct {
+	Projects        domain.ProjectCatalog
+	Activity        domain.RepoActivitySource
+	Scorer          domain.ScoreCalculator
+	ReportRenderer  domain.CommunityReportRenderer
+	SummaryRenderer domain.CommunitySummaryRenderer
+	CardRenderer    domain.CommunityCardRenderer
+	Writer          domain.OutputWriter
+	Actions         *github.Actions
+	MinStars        int // Filters owned projects when no repos are given
+	Window          domain.TimeWindow
+	RepoMetrics     map[string]domain.RepoMetrics // Lowercased repo name to adoption data, for the dependents popularity model
+	StarHistory     domain.StarHistorySource      // When set, popularity uses each event's estimated stars at CreatedAt
+}
+
+// Run writes a community report.json, summary.md and card.svg for repos, or
+// for every owned project with at least MinStars stars when repos is empty.
+// Bare repo names are taken to belong to owner.
+func (g *CommunityGenerator) Run(ctx context.Context, owner string, repos []string) error {
+	if g.Projects == nil || g.Activity == nil || g.Scorer == nil || g.ReportRenderer == nil || g.SummaryRenderer == nil || g.Writer == nil {
+		return fmt.Errorf("community generator dependencies are not fully configured")
+	}
+
+	scope := domain.CommunityScope{Owner: owner}
+	if len(repos) == 0 {
+		projects, err := g.Projects.FetchOwnedProjects(ctx, owner)
+		if err != nil {
+			return fmt.Errorf("fetching owned projects: %w", err)
+		}
+		for _, p := range projects {
+			if p.Stars >= g.MinStars {
+				scope.Repos = append(scope.Repos, p.Repo)
+			}
+		}
+		sort.Strings(scope.Repos)
+	}
+	for _, repo := range repos {
+		if !strings.Contains(repo, "/") {
+			repo = owner + "/" + repo
+		}
+		scope.Repos = append(scope.Repos, repo)
+	}
+	if len(scope.Repos) == 0 {
+		return fmt.Errorf("no owned repos to report on for %s", owner)
+	}
+
+	// Merge each contributor's events across repos before scoring so decay
+	// matches their individual footprint.
+	contributors := make(map[string]*domain.ContributorActivity)
+	var pulls []domain.PullRequestActivity
+	for _, repo := range scope.Repos {
+		activity, err := g.Activity.FetchRepoActivity(ctx, repo, g.Window)
+		if err != nil {
+			return fmt.Errorf("fetching activity for %s: %w", repo, err)
+		}
+		pulls = append(pulls, activity.PullRequests...)
+		for _, c := range activity.Contributors {
+			key := strings.ToLower(c.User.Username)
+			if contributors[key] == nil {
+				contributors[key] = &domain.ContributorActivity{User: c.User}
+			}
+			contributors[key].Events = append(contributors[key].Events, c.Events...)
+		}
+	}
+
+	var members []domain.MemberActivity
+	for _, c := range contributors {
+		applyRepoMetrics(g.RepoMetrics, c.Events, nil)
+		if err := applyStarHistory(ctx, g.StarHistory, c.Events); err != nil {
+			return fmt.Errorf("estimating stars at contribution time: %w", err)
+		}
+		events := g.Scorer.ScoreBatch(c.Events)
+		members = append(members, domain.MemberActivity{
+			User:   c.User,
+			Events: logic.MapClassify(events),
+		})
+	}
+
+	community := logic.AggregateCommunity(scope, members, pulls, g.Scorer.Config())
+	generatedAt := time.Now()
+
+	reportJSON, err := g.ReportRenderer.RenderCommunityReport(ctx, community, generatedAt)
+	if err != nil {
+		return fmt.Errorf("rendering community report: %w", err)
+	}
+
+	summaryMD, err := g.SummaryRenderer.RenderCommunitySummary(ctx, community, generatedAt)
+	if err != nil {
+		return fmt.Errorf("rendering community summary: %w", err)
+	}
+
+	if err := g.Writer.Write(ctx, "report.json", reportJSON); err != nil {
+		return fmt.Errorf("writing report.json: %w", err)
+	}
+
+	if err := g.Writer.Write(ctx, "summary.md", summaryMD); err != nil {
+		return fmt.Errorf("writing summary.md: %w", err)
+	}
+
+	if g.Actions != nil {
+		if err := g.Actions.WriteSummary(summaryMD); err != nil {
+			fmt.Printf("Warning: failed to write job summary: %v\n", err)
+		}
+
+		g.Actions.SetOutput("total_contributions", fmt.Sprintf("%d", community.Events))
+		g.Actions.SetOutput("total_score", fmt.Sprintf("%.2f", community.TotalScore))
+		g.Actions.SetOutput("lifetime_score", fmt.Sprintf("%.2f", community.LifetimeScore))
+	}
+
+	if g.CardRenderer != nil {
+		assetMap := assets.FetchCommunityAssets(community)
+
+		cardSVG, err := g.CardRenderer.RenderCommunityCard(ctx, community, generatedAt, assetMap)
+		if err != nil {
+			return fmt.Errorf("rendering community card: %w", err)
+		}
+		if err := g.Writer.Write(ctx, "card.svg", cardSVG); err != nil {
+			return fmt.Errorf("writing card.svg: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+type fakeActivity map[string]domain.RepoActivity
+
+func (f fakeActivity) FetchRepoActivity(ctx context.Context, repo string, window domain.TimeWindow) (domain.RepoActivity, error) {
+	return f[repo], nil
+}
+
+type fakeCommunityRenderer struct {
+	community domain.CommunityFootprint
+}
+
+func (f *fakeCommunityRenderer) RenderCommunityReport(ctx context.Context, community domain.CommunityFootprint, generatedAt time.Time) ([]byte, error) {
+	f.community = community
+	return []byte("community-report"), nil
+}
+
+func (f *fakeCommunityRenderer) RenderCommunitySummary(ctx context.Context, community domain.CommunityFootprint, generatedAt time.Time) ([]byte, error) {
+	return []byte("community-summary"), nil
+}
+
+func TestCommunityGeneratorRun_MergesContributorsAcrossOwnedRepos(t *testing.T) {
+	ana := domain.User{Username: "ana"}
+	activity := fakeActivity{
+		"ray/tool": {
+			Repo: "ray/tool",
+			Contributors: []domain.ContributorActivity{
+				{User: ana, Events: []domain.ContributionEvent{{ID: "pr1", Type: domain.ContributionTypePR, Repo: "ray/tool"}}},
+				{User: domain.User{Username: "ray"}, Events: []domain.ContributionEvent{{ID: "pr2", Type: domain.ContributionTypePR, Repo: "ray/tool"}}},
+			},
+			PullRequests: []domain.PullRequestActivity{{Repo: "ray/tool", Author: "ana", Merged: true}},
+		},
+		"ray/lib": {
+			Repo: "ray/lib",
+			Contributors: []domain.ContributorActivity{
+				{User: domain.User{Username: "Ana"}, Events: []domain.ContributionEvent{{ID: "i1", Type: domain.ContributionTypeIssue, Repo: "ray/lib"}}},
+			},
+		},
+		"ray/small": {Repo: "ray/small"},
+	}
+	projects := fakeProjects{projects: []domain.OwnedProject{
+		{Repo: "ray/tool", Stars: 50},
+		{Repo: "ray/lib", Stars: 10},
+		{Repo: "ray/small", Stars: 1},
+	}}
+	renderer := &fakeCommunityRenderer{}
+	writer := &fakeWriter{}
+
+	gen := &CommunityGenerator{
+		Projects:        projects,
+		Activity:        activity,
+		Scorer:          fakeScorer{},
+		ReportRenderer:  renderer,
+		SummaryRenderer: renderer,
+		Writer:          writer,
+		MinStars:        5,
+	}
+
+	if err := gen.Run(context.Background(), "ray", nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	got := renderer.community
+	if !reflect.DeepEqual(got.Scope.Repos, []string{"ray/lib", "ray/tool"}) {
+		t.Errorf("expected owned repos above min stars, got %v", got.Scope.Repos)
+	}
+	if len(got.Contributors) != 1 {
+		t.Fatalf("expected the owner to be excluded, got %d contributors", len(got.Contributors))
+	}
+	if c := got.Contributors[0]; c.Stats.PRsOpened != 1 || c.Stats.IssuesOpened != 1 {
+		t.Errorf("expected ana's events to be merged across repos, got %+v", c.Stats)
+	}
+	if got.BusFactor.Authors != 1 {
+		t.Errorf("expected bus factor 1, got %d", got.BusFactor.Authors)
+	}
+	for _, name := range []string{"report.json", "summary.md"} {
+		if _, ok := writer.writes[name]; !ok {
+			t.Errorf("expected %s to be written", name)
+		}
+	}
+}
+
+func TestCommunityGeneratorRun_QualifiesBareRepoNames(t *testing.T) {
+	renderer := &fakeCommunityRenderer{}
+	gen := &CommunityGenerator{
+		Projects:        fakeProjects{},
+		Activity:        fakeActivity{},
+		Scorer:          fakeScorer{},
+		ReportRenderer:  renderer,
+		SummaryRenderer: renderer,
+		Writer:          &fakeWriter{},
+	}
+
+	if err := gen.Run(context.Background(), "ray", []string{"tool", "acme/lib"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(renderer.community.Scope.Repos, []string{"ray/tool", "acme/lib"}) {
+		t.Errorf("unexpected repos %v", renderer.community.Scope.Repos)
+	}
+}
@@ -0,0 +1,161 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/arayofcode/footprint/internal/render/report"
+)
+
+// RunExplain prints how the events behind target scored, from a report.json
+// written by a previous run. target is a repo ("owner/name" or its GitHub
+// URL) or the URL of a single event.
+func RunExplain(w io.Writer, reportPath, target string) error {
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		return fmt.Errorf("reading report: %w", err)
+	}
+	var r report.Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return fmt.Errorf("parsing %s: %w", reportPath, err)
+	}
+
+	for _, e := range r.Events {
+		if e.URL == target {
+			if e.Breakdown == nil {
+				return fmt.Errorf("%s has no score breakdowns; regenerate it to explain scores", reportPath)
+			}
+			fmt.Fprintf(w, "%s\n\n", e.Repo)
+			explainEvent(w, e, r.Scoring)
+			return nil
+		}
+	}
+
+	repo := strings.TrimSuffix(strings.TrimPrefix(target, "https://github.com/"), "/")
+	var events []domain.Contribution
+	for _, e := range r.Events {
+		if strings.EqualFold(e.Repo, repo) {
+			events = append(events, e)
+		}
+	}
+	if len(events) == 0 {
+		return fmt.Errorf("no repo or event matching %q in %s", target, reportPath)
+	}
+	for _, e := range events {
+		if e.Breakdown == nil {
+			return fmt.Errorf("%s has no score breakdowns; regenerate it to explain scores", reportPath)
+		}
+	}
+
+	// Largest shares first
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Breakdown.Score > events[j].Breakdown.Score
+	})
+
+	impact := 0.0
+	for _, tr := range r.TopRepos {
+		if strings.EqualFold(tr.Repo, repo) {
+			impact = tr.ImpactScore
+		}
+	}
+	fmt.Fprintf(w, "%s: %.2f impact from %d events\n\n", events[0].Repo, impact, len(events))
+
+	total := 0.0
+	for _, e := range events {
+		explainEvent(w, e, r.Scoring)
+		total += e.Breakdown.Score
+	}
+	fmt.Fprintf(w, "Total: %.2f\n", total)
+	return nil
+}
+
+// explainEvent prints one event and its score as a formula, leaving out
+// factors that are 1 and bonuses that are 0. scoring, when the report embeds
+// it, describes which events decayed together.
+func explainEvent(w io.Writer, e domain.Contribution, scoring *report.ScoringInfo) {
+	b := e.Breakdown
+
+	heading := string(e.Type)
+	if e.Title != "" {
+		heading += fmt.Sprintf(" %q", e.Title)
+	}
+	fmt.Fprintf(w, "%s · %s\n%s\n", heading, e.CreatedAt.Format("2006-01-02"), e.URL)
+
+	base := fmt.Sprintf("%.2f base", b.Base)
+	extended := false
+	if len(b.Trivial) > 0 {
+		base += fmt.Sprintf(" × %.2f trivial (%s)", b.TrivialFactor, joinReasons(b.Trivial))
+		extended = true
+	}
+	if b.MergedBonus != 1 {
+		base += fmt.Sprintf(" × %.2f merged bonus", b.MergedBonus)
+		extended = true
+	}
+	if b.ResolvedIssues != 0 {
+		base += fmt.Sprintf(" + %.2f resolved issues", b.ResolvedIssues)
+		extended = true
+	}
+	if b.Reactions != 0 {
+		base += fmt.Sprintf(" + %.2f reactions", b.Reactions)
+		extended = true
+	}
+	if extended {
+		base = "(" + base + ")"
+	}
+
+	formula := base
+	for _, r := range b.Rules {
+		if r.Multiply != 0 {
+			formula += fmt.Sprintf(" × %.2f rule %q", r.Multiply, r.Rule)
+		}
+		if r.Add != 0 {
+			formula = fmt.Sprintf("(%s + %.2f rule %q)", formula, r.Add, r.Rule)
+		}
+	}
+	if b.DecayFactor != 1 {
+		formula += fmt.Sprintf(" × %.2f decay (%s)", b.DecayFactor, describeDecay(b.DecayIndex, scoring))
+	}
+	if b.Recency != 1 {
+		formula += fmt.Sprintf(" × %.2f recency", b.Recency)
+	}
+	formula += fmt.Sprintf(" × %.2f popularity", b.Multiplier)
+	if b.Capped {
+		formula += " (capped)"
+	}
+	fmt.Fprintf(w, "  %s = %.2f\n\n", formula, b.Score)
+}
+
+// describeDecay places an event in its decay group, e.g. "#2 of its type in
+// the repo".
+func describeDecay(index int, scoring *report.ScoringInfo) string {
+	var config domain.ScoringConfig
+	if scoring != nil {
+		config = scoring.Config
+	}
+	group := "of its type in the repo"
+	if config.DecayAcrossTypes {
+		group = "in the repo"
+	}
+	desc := fmt.Sprintf("#%d %s", index+1, group)
+	switch config.Strategy() {
+	case domain.DecayWindowed:
+		desc += " that " + string(config.Window())
+	case domain.DecayBudgeted:
+		desc += fmt.Sprintf(", over its %.2f budget", config.DecayBudget)
+	}
+	return desc
+}
+
+// joinReasons lists trivial PR signals, e.g. "docs-only, small".
+func joinReasons(reasons []domain.TrivialReason) string {
+	parts := make([]string, len(reasons))
+	for i, r := range reasons {
+		parts[i] = string(r)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/arayofcode/footprint/internal/render/report"
+)
+
+func writeExplainReport(t *testing.T, events []domain.Contribution) string {
+	t.Helper()
+	data, err := json.Marshal(report.Report{
+		Events:   events,
+		TopRepos: []report.RepoImpact{{Repo: "ext/repo", ImpactScore: 66}},
+	})
+	if err != nil {
+		t.Fatalf("marshaling report: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("writing report: %v", err)
+	}
+	return path
+}
+
+func TestRunExplain_PrintsRepoCalculation(t *testing.T) {
+	created := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	path := writeExplainReport(t, []domain.Contribution{
+		{
+			Type: domain.ContributionIssueComment, Repo: "ext/repo", URL: "https://github.com/ext/repo/issues/1#c2", CreatedAt: created,
+			Breakdown: &domain.ScoreBreakdown{Base: 2, MergedBonus: 1, DecayIndex: 1, DecayFactor: 0.5, Recency: 1, Multiplier: 3, Score: 3},
+		},
+		{
+			Type: domain.ContributionPR, Repo: "ext/repo", Title: "Fix parser", URL: "https://github.com/ext/repo/pull/2", CreatedAt: created,
+			Breakdown: &domain.ScoreBreakdown{Base: 10, MergedBonus: 1.5, ResolvedIssues: 6, DecayFactor: 1, Recency: 1, Multiplier: 3, Score: 63},
+		},
+	})
+
+	var out bytes.Buffer
+	if err := RunExplain(&out, path, "https://github.com/ext/repo"); err != nil {
+		t.Fatalf("RunExplain: %v", err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		"ext/repo: 66.00 impact from 2 events",
+		"(10.00 base × 1.50 merged bonus + 6.00 resolved issues) × 3.00 popularity = 63.00",
+		"2.00 base × 0.50 decay (#2 of its type in the repo) × 3.00 popularity = 3.00",
+		"Total: 66.00",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "Fix parser") > strings.Index(got, "ISSUE_COMMENT") {
+		t.Errorf("expected the largest share first, got:\n%s", got)
+	}
+}
+
+func TestRunExplain_SingleEvent(t *testing.T) {
+	path := writeExplainReport(t, []domain.Contribution{{
+		Type: domain.ContributionPR, Repo: "ext/repo", URL: "https://github.com/ext/repo/pull/2",
+		Breakdown: &domain.ScoreBreakdown{Base: 10, MergedBonus: 1, DecayFactor: 1, Recency: 0.5, Multiplier: 4, Capped: true, Score: 20},
+	}})
+
+	var out bytes.Buffer
+	if err := RunExplain(&out, path, "https://github.com/ext/repo/pull/2"); err != nil {
+		t.Fatalf("RunExplain: %v", err)
+	}
+	if want := "10.00 base × 0.50 recency × 4.00 popularity (capped) = 20.00"; !strings.Contains(out.String(), want) {
+		t.Errorf("expected %q, got:\n%s", want, out.String())
+	}
+}
+
+func TestRunExplain_ShowsRules(t *testing.T) {
+	path := writeExplainReport(t, []domain.Contribution{{
+		Type: domain.ContributionPRReview, Repo: "ext/repo", URL: "https://github.com/ext/repo/pull/3",
+		Breakdown: &domain.ScoreBreakdown{
+			Base: 3, MergedBonus: 1, DecayFactor: 1, Recency: 1, Multiplier: 2, Score: 22,
+			Rules: []domain.AppliedRule{{Rule: "big repos", Multiply: 2}, {Rule: "security", Add: 5}},
+		},
+	}})
+
+	var out bytes.Buffer
+	if err := RunExplain(&out, path, "https://github.com/ext/repo/pull/3"); err != nil {
+		t.Fatalf("RunExplain: %v", err)
+	}
+	if want := `(3.00 base × 2.00 rule "big repos" + 5.00 rule "security") × 2.00 popularity = 22.00`; !strings.Contains(out.String(), want) {
+		t.Errorf("expected %q, got:\n%s", want, out.String())
+	}
+}
+
+func TestRunExplain_ShowsTrivialFlag(t *testing.T) {
+	path := writeExplainReport(t, []domain.Contribution{{
+		Type: domain.ContributionPR, Repo: "ext/repo", URL: "https://github.com/ext/repo/pull/4", Trivial: []domain.TrivialReason{domain.TrivialDocsOnly},
+		Breakdown: &domain.ScoreBreakdown{
+			Base: 10, Trivial: []domain.TrivialReason{domain.TrivialDocsOnly}, TrivialFactor: 0.25,
+			MergedBonus: 1.5, DecayFactor: 1, Recency: 1, Multiplier: 2, Score: 7.5,
+		},
+	}})
+
+	var out bytes.Buffer
+	if err := RunExplain(&out, path, "https://github.com/ext/repo/pull/4"); err != nil {
+		t.Fatalf("RunExplain: %v", err)
+	}
+	if want := "(10.00 base × 0.25 trivial (docs-only) × 1.50 merged bonus) × 2.00 popularity = 7.50"; !strings.Contains(out.String(), want) {
+		t.Errorf("expected %q, got:\n%s", want, out.String())
+	}
+}
+
+func TestDescribeDecay(t *testing.T) {
+	tests := []struct {
+		config *domain.ScoringConfig
+		want   string
+	}{
+		{nil, "#2 of its type in the repo"},
+		{&domain.ScoringConfig{DecayAcrossTypes: true}, "#2 in the repo"},
+		{&domain.ScoringConfig{DecayStrategy: domain.DecayWindowed, DecayWindow: domain.DecayWeek}, "#2 of its type in the repo that week"},
+		{&domain.ScoringConfig{DecayStrategy: domain.DecayBudgeted, DecayBudget: 20}, "#2 of its type in the repo, over its 20.00 budget"},
+	}
+	for _, tt := range tests {
+		var scoring *report.ScoringInfo
+		if tt.config != nil {
+			scoring = &report.ScoringInfo{Config: *tt.config}
+		}
+		if got := describeDecay(1, scoring); got != tt.want {
+			t.Errorf("expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestRunExplain_Errors(t *testing.T) {
+	path := writeExplainReport(t, []domain.Contribution{{Type: domain.ContributionPR, Repo: "ext/repo", URL: "https://github.com/ext/repo/pull/2"}})
+
+	if err := RunExplain(&bytes.Buffer{}, path, "other/repo"); err == nil || !strings.Contains(err.Error(), "no repo or event matching") {
+		t.Errorf("expected unknown target error, got %v", err)
+	}
+	if err := RunExplain(&bytes.Buffer{}, path, "ext/repo"); err == nil || !strings.Contains(err.Error(), "no score breakdowns") {
+		t.Errorf("expected missing breakdown error, got %v", err)
+	}
+}
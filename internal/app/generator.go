@@ -2,7 +2,9 @@ package app
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/arayofcode/footprint/internal/domain"
@@ -21,6 +23,17 @@ type Generator struct {
 	Writer          domain.OutputWriter
 	Actions         *github.Actions
 	MinStars        int
+	Window          domain.TimeWindow             // Limits contributions; owned projects are always counted
+	RepoMetrics     map[string]domain.RepoMetrics // Lowercased repo name to adoption data, for the dependents popularity model
+	StarHistory     domain.StarHistorySource      // When set, popularity uses each event's estimated stars at CreatedAt
+	History         domain.HistoryStore           // When set, Render reports the trend since earlier runs and appends a snapshot
+	DigestRenderer  domain.DigestRenderer         // When set, writes what changed since the previous run
+	Previous        *domain.Baseline              // Previous run's report, for the digest; the latest history snapshot when nil
+
+	// Private, when set, adds aggregate-only private contribution stats.
+	Private domain.PrivateActivitySource
+	// ScorePrivate counts private contributions towards the impact score.
+	ScorePrivate bool
 }
 
 func (g *Generator) Run(ctx context.Context, username string) error {
@@ -28,7 +41,7 @@ func (g *Generator) Run(ctx context.Context, username string) error {
 		return fmt.Errorf("generator dependencies are not fully configured")
 	}
 
-	user, events, err := g.Fetcher.FetchExternalContributions(ctx, username)
+	user, events, err := g.Fetcher.FetchExternalContributions(ctx, username, g.Window)
 	if err != nil {
 		return fmt.Errorf("fetching external contributions: %w", err)
 	}
@@ -38,14 +51,62 @@ func (g *Generator) Run(ctx context.Context, username string) error {
 		return fmt.Errorf("fetching owned projects: %w", err)
 	}
 
-	events = g.Scorer.ScoreBatch(events)
-	enrichedProjects := enrichOwnedProjects(g.Scorer, projects)
+	applyRepoMetrics(g.RepoMetrics, events, projects)
+	if err := applyStarHistory(ctx, g.StarHistory, events); err != nil {
+		return fmt.Errorf("estimating stars at contribution time: %w", err)
+	}
 
-	// Semantic Pipeline
-	semanticEvents := logic.MapClassify(events)
-	statsView, repoContribs, projectImpacts := logic.Aggregate(semanticEvents, enrichedProjects)
+	dump := domain.EventDump{
+		SchemaVersion: domain.EventDumpVersion,
+		FetchedAt:     time.Now(),
+		User:          user,
+		Window:        g.Window,
+		Scoring:       g.Scorer.Config(),
+		Events:        events,
+		Projects:      projects,
+	}
+
+	if g.Private != nil {
+		private, err := g.Private.FetchPrivateContributions(ctx, username, g.Window)
+		if err != nil {
+			return fmt.Errorf("fetching private contributions: %w", err)
+		}
+		dump.Private = &private
+	}
+
+	dumpJSON, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling events: %w", err)
+	}
+	if err := g.Writer.Write(ctx, "events.json", dumpJSON); err != nil {
+		return fmt.Errorf("writing events.json: %w", err)
+	}
+
+	return g.Render(ctx, dump)
+}
+
+// Render scores a dump and writes every artifact except events.json. It needs
+// no fetchers, so a saved dump can be rescored offline.
+func (g *Generator) Render(ctx context.Context, dump domain.EventDump) error {
+	if g.Scorer == nil || g.ReportRenderer == nil || g.SummaryRenderer == nil || g.Writer == nil {
+		return fmt.Errorf("generator dependencies are not fully configured")
+	}
+
+	user, events := dump.User, dump.Events
+	scored := scoreDump(g.Scorer, dump)
+	semanticEvents, enrichedProjects := scored.events, scored.projects
+	statsView, repoContribs, projectImpacts := scored.stats, scored.repos, scored.projectImpacts
+
+	if dump.Private != nil {
+		private := *dump.Private
+		if g.ScorePrivate {
+			private = g.Scorer.ScorePrivate(private)
+		}
+		statsView.Private = &private
+	}
 
 	// Projection adapter: Attach finalized contributions to repo summaries
+	semanticEvents = logic.AttachBreakdowns(semanticEvents, repoContribs, g.Scorer.Config())
 	finalizedEvents := domain.MapEventsToContributions(semanticEvents)
 	repoEvents := make(map[string][]domain.Contribution)
 	for _, fe := range finalizedEvents {
@@ -57,12 +118,30 @@ func (g *Generator) Run(ctx context.Context, username string) error {
 
 	generatedAt := time.Now()
 
-	reportJSON, err := g.ReportRenderer.RenderReport(ctx, user, statsView, generatedAt, repoContribs, projectImpacts)
+	insights := domain.Insights{
+		Milestones: logic.DetectMilestones(semanticEvents, enrichedProjects, generatedAt),
+		Activity:   logic.BucketActivity(semanticEvents, repoContribs, g.Scorer.Config()),
+		Languages:  logic.ShareByLanguage(repoContribs, projectImpacts),
+	}
+
+	var snapshot domain.Snapshot
+	var history []domain.Snapshot
+	if g.History != nil {
+		var err error
+		history, err = g.History.LoadHistory(ctx)
+		if err != nil {
+			return fmt.Errorf("loading history: %w", err)
+		}
+		snapshot = logic.TakeSnapshot(generatedAt, g.Scorer.Config().Hash(), statsView, repoContribs, projectImpacts)
+		insights.Trend = logic.ComputeTrend(history, snapshot)
+	}
+
+	reportJSON, err := g.ReportRenderer.RenderReport(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, insights)
 	if err != nil {
 		return fmt.Errorf("rendering report: %w", err)
 	}
 
-	summaryMD, err := g.SummaryRenderer.RenderSummary(ctx, user, statsView, generatedAt, repoContribs, projectImpacts)
+	summaryMD, err := g.SummaryRenderer.RenderSummary(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, insights)
 	if err != nil {
 		return fmt.Errorf("rendering summary: %w", err)
 	}
@@ -75,22 +154,42 @@ func (g *Generator) Run(ctx context.Context, username string) error {
 		return fmt.Errorf("writing summary.md: %w", err)
 	}
 
+	if g.DigestRenderer != nil {
+		baseline := g.Previous
+		if baseline == nil && len(history) > 0 {
+			latest := logic.SnapshotBaseline(history[len(history)-1])
+			baseline = &latest
+		}
+		digest := logic.ComputeDigest(baseline, statsView, repoContribs, projectImpacts)
+
+		digestMD, err := g.DigestRenderer.RenderDigest(ctx, user, generatedAt, digest)
+		if err != nil {
+			return fmt.Errorf("rendering digest: %w", err)
+		}
+		if err := g.Writer.Write(ctx, "digest.md", digestMD); err != nil {
+			return fmt.Errorf("writing digest.md: %w", err)
+		}
+
+		payload, err := g.DigestRenderer.RenderDigestPayload(ctx, user, generatedAt, digest)
+		if err != nil {
+			return fmt.Errorf("rendering digest payload: %w", err)
+		}
+		if err := g.Writer.Write(ctx, "digest.json", payload); err != nil {
+			return fmt.Errorf("writing digest.json: %w", err)
+		}
+	}
+
 	if g.Actions != nil {
 		if err := g.Actions.WriteSummary(summaryMD); err != nil {
 			fmt.Printf("Warning: failed to write job summary: %v\n", err)
 		}
 
-		totalScore := 0.0
-		for _, r := range repoContribs {
-			totalScore += r.Score
-		}
-		for _, p := range projectImpacts {
-			totalScore += p.Score
-		}
+		totals := domain.TotalScores(repoContribs, projectImpacts, statsView.Private)
 
 		g.Actions.SetOutput("total_contributions", fmt.Sprintf("%d", len(events)))
 		g.Actions.SetOutput("owned_projects_count", fmt.Sprintf("%d", len(projectImpacts)))
-		g.Actions.SetOutput("total_score", fmt.Sprintf("%.2f", totalScore))
+		g.Actions.SetOutput("total_score", fmt.Sprintf("%.2f", totals.Recent))
+		g.Actions.SetOutput("lifetime_score", fmt.Sprintf("%.2f", totals.Lifetime))
 	}
 
 	if g.CardRenderer != nil {
@@ -98,7 +197,7 @@ func (g *Generator) Run(ctx context.Context, username string) error {
 		assetMap := assets.FetchAssets(user, repoContribs, projectImpacts)
 
 		// Render cards with finalized impact
-		cardSVG, err := g.CardRenderer.RenderCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, assetMap)
+		cardSVG, err := g.CardRenderer.RenderCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, insights, assetMap)
 		if err != nil {
 			return fmt.Errorf("rendering card: %w", err)
 		}
@@ -107,7 +206,7 @@ func (g *Generator) Run(ctx context.Context, username string) error {
 		}
 
 		// Minimal card
-		minimalSVG, err := g.CardRenderer.RenderMinimalCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, assetMap)
+		minimalSVG, err := g.CardRenderer.RenderMinimalCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, insights, assetMap)
 		if err != nil {
 			return fmt.Errorf("rendering minimal card: %w", err)
 		}
@@ -116,7 +215,7 @@ func (g *Generator) Run(ctx context.Context, username string) error {
 		}
 
 		// Extended card
-		extendedSVG, err := g.CardRenderer.RenderExtendedCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, assetMap)
+		extendedSVG, err := g.CardRenderer.RenderExtendedCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, insights, assetMap)
 		if err != nil {
 			return fmt.Errorf("rendering extended card: %w", err)
 		}
@@ -125,22 +224,82 @@ func (g *Generator) Run(ctx context.Context, username string) error {
 		}
 
 		// Extended-minimal card
-		extMinimalSVG, err := g.CardRenderer.RenderExtendedMinimalCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, assetMap)
+		extMinimalSVG, err := g.CardRenderer.RenderExtendedMinimalCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, insights, assetMap)
 		if err != nil {
 			return fmt.Errorf("rendering extended-minimal card: %w", err)
 		}
 		if err := g.Writer.Write(ctx, "card-extended-minimal.svg", extMinimalSVG); err != nil {
 			return fmt.Errorf("writing card-extended-minimal.svg: %w", err)
 		}
+
+		// Heatmap card
+		heatmapSVG, err := g.CardRenderer.RenderHeatmapCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, insights, assetMap)
+		if err != nil {
+			return fmt.Errorf("rendering heatmap card: %w", err)
+		}
+		if err := g.Writer.Write(ctx, "card-heatmap.svg", heatmapSVG); err != nil {
+			return fmt.Errorf("writing card-heatmap.svg: %w", err)
+		}
+
+		// Language card
+		languagesSVG, err := g.CardRenderer.RenderLanguageCard(ctx, user, statsView, generatedAt, repoContribs, projectImpacts, insights, assetMap)
+		if err != nil {
+			return fmt.Errorf("rendering language card: %w", err)
+		}
+		if err := g.Writer.Write(ctx, "card-languages.svg", languagesSVG); err != nil {
+			return fmt.Errorf("writing card-languages.svg: %w", err)
+		}
+	}
+
+	// Appended last, so a failed run never becomes the next run's baseline
+	if g.History != nil {
+		if err := g.History.AppendSnapshot(ctx, snapshot); err != nil {
+			return fmt.Errorf("appending to history: %w", err)
+		}
 	}
 
 	return nil
 }
 
-func enrichOwnedProjects(calculator domain.ScoreCalculator, projects []domain.OwnedProject) []domain.EnrichedProject {
-	enriched := make([]domain.EnrichedProject, 0, len(projects))
-	for _, project := range projects {
-		enriched = append(enriched, calculator.EnrichOwnedProject(project))
+// scoredDump is a dump after scoring and aggregation.
+type scoredDump struct {
+	events         []domain.SemanticEvent
+	projects       []domain.EnrichedProject
+	stats          domain.StatsView
+	repos          []domain.RepoContribution
+	projectImpacts []domain.OwnedProjectImpact
+}
+
+func scoreDump(scorer domain.ScoreCalculator, dump domain.EventDump) scoredDump {
+	events := scorer.ScoreBatch(dump.Events)
+	projects := scorer.EnrichOwnedProjects(dump.Projects)
+
+	// Semantic Pipeline
+	semanticEvents := logic.MapClassify(events)
+	stats, repos, projectImpacts := logic.Aggregate(semanticEvents, projects, scorer.Config())
+	return scoredDump{
+		events:         semanticEvents,
+		projects:       projects,
+		stats:          stats,
+		repos:          repos,
+		projectImpacts: projectImpacts,
+	}
+}
+
+// applyRepoMetrics copies known dependents and downloads onto events and
+// projects, in place.
+func applyRepoMetrics(metrics map[string]domain.RepoMetrics, events []domain.ContributionEvent, projects []domain.OwnedProject) {
+	if len(metrics) == 0 {
+		return
+	}
+	for i := range events {
+		if m, ok := metrics[strings.ToLower(events[i].Repo)]; ok {
+			events[i].Dependents, events[i].Downloads = m.Dependents, m.Downloads
+		}
+	}
+	for i := range projects {
+		if m, ok := metrics[strings.ToLower(projects[i].Repo)]; ok {
+			projects[i].Dependents, projects[i].Downloads = m.Dependents, m.Downloads
+		}
 	}
-	return enriched
 }
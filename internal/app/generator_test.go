@@ -2,20 +2,27 @@ package app
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/arayofcode/footprint/internal/render/summary"
+	"github.com/arayofcode/footprint/internal/scoring"
 )
 
 type fakeFetcher struct {
 	events []domain.ContributionEvent
 	user   domain.User
 	err    error
+	window *domain.TimeWindow // Records the window requested, when set
 }
 
-func (f fakeFetcher) FetchExternalContributions(ctx context.Context, username string) (domain.User, []domain.ContributionEvent, error) {
+func (f fakeFetcher) FetchExternalContributions(ctx context.Context, username string, window domain.TimeWindow) (domain.User, []domain.ContributionEvent, error) {
+	if f.window != nil {
+		*f.window = window
+	}
 	if f.err != nil {
 		return domain.User{}, nil, f.err
 	}
@@ -62,21 +69,49 @@ func (fakeScorer) EnrichOwnedProject(project domain.OwnedProject) domain.Enriche
 	}
 }
 
+func (f fakeScorer) EnrichOwnedProjects(projects []domain.OwnedProject) []domain.EnrichedProject {
+	enriched := make([]domain.EnrichedProject, len(projects))
+	for i, p := range projects {
+		enriched[i] = f.EnrichOwnedProject(p)
+	}
+	return enriched
+}
+
+func (fakeScorer) Config() domain.ScoringConfig {
+	return scoring.DefaultConfig()
+}
+
+func (fakeScorer) ScorePrivate(private domain.PrivateContributions) domain.PrivateContributions {
+	private.Score = 7
+	return private
+}
+
+type fakePrivate struct {
+	private domain.PrivateContributions
+	err     error
+}
+
+func (f fakePrivate) FetchPrivateContributions(ctx context.Context, username string, window domain.TimeWindow) (domain.PrivateContributions, error) {
+	return f.private, f.err
+}
+
 type fakeReportRenderer struct {
 	user        domain.User
 	stats       domain.StatsView
 	generatedAt time.Time
 	projects    []domain.RepoContribution
 	owned       []domain.OwnedProjectImpact
+	insights    domain.Insights
 	err         error
 }
 
-func (f *fakeReportRenderer) RenderReport(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, projects []domain.RepoContribution, owned []domain.OwnedProjectImpact) ([]byte, error) {
+func (f *fakeReportRenderer) RenderReport(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, projects []domain.RepoContribution, owned []domain.OwnedProjectImpact, insights domain.Insights) ([]byte, error) {
 	f.user = user
 	f.stats = stats
 	f.generatedAt = generatedAt
 	f.projects = projects
 	f.owned = owned
+	f.insights = insights
 	if f.err != nil {
 		return nil, f.err
 	}
@@ -92,7 +127,7 @@ type fakeSummaryRenderer struct {
 	err         error
 }
 
-func (f *fakeSummaryRenderer) RenderSummary(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, projects []domain.RepoContribution, owned []domain.OwnedProjectImpact) ([]byte, error) {
+func (f *fakeSummaryRenderer) RenderSummary(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, projects []domain.RepoContribution, owned []domain.OwnedProjectImpact, insights domain.Insights) ([]byte, error) {
 	f.user = user
 	f.stats = stats
 	f.generatedAt = generatedAt
@@ -109,7 +144,7 @@ type fakeCardRenderer struct {
 	err    error
 }
 
-func (f *fakeCardRenderer) RenderCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, assets map[domain.AssetKey]string) ([]byte, error) {
+func (f *fakeCardRenderer) RenderCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
 	f.called = true
 	if f.err != nil {
 		return nil, f.err
@@ -117,27 +152,41 @@ func (f *fakeCardRenderer) RenderCard(ctx context.Context, user domain.User, sta
 	return []byte("card"), nil
 }
 
-func (f *fakeCardRenderer) RenderMinimalCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, assets map[domain.AssetKey]string) ([]byte, error) {
+func (f *fakeCardRenderer) RenderMinimalCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
 	if f.err != nil {
 		return nil, f.err
 	}
 	return []byte("minimal-card"), nil
 }
 
-func (f *fakeCardRenderer) RenderExtendedCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, assets map[domain.AssetKey]string) ([]byte, error) {
+func (f *fakeCardRenderer) RenderExtendedCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
 	if f.err != nil {
 		return nil, f.err
 	}
 	return []byte("extended-card"), nil
 }
 
-func (f *fakeCardRenderer) RenderExtendedMinimalCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, assets map[domain.AssetKey]string) ([]byte, error) {
+func (f *fakeCardRenderer) RenderExtendedMinimalCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
 	if f.err != nil {
 		return nil, f.err
 	}
 	return []byte("extended-minimal-card"), nil
 }
 
+func (f *fakeCardRenderer) RenderHeatmapCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []byte("heatmap-card"), nil
+}
+
+func (f *fakeCardRenderer) RenderLanguageCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []byte("language-card"), nil
+}
+
 type fakeWriter struct {
 	writes map[string][]byte
 	err    error
@@ -154,6 +203,19 @@ func (f *fakeWriter) Write(ctx context.Context, filename string, data []byte) er
 	return nil
 }
 
+type fakeHistory struct {
+	snapshots []domain.Snapshot
+}
+
+func (f *fakeHistory) LoadHistory(ctx context.Context) ([]domain.Snapshot, error) {
+	return f.snapshots, nil
+}
+
+func (f *fakeHistory) AppendSnapshot(ctx context.Context, snapshot domain.Snapshot) error {
+	f.snapshots = append(f.snapshots, snapshot)
+	return nil
+}
+
 func TestGeneratorRun_Success_NoCard(t *testing.T) {
 	fetcher := fakeFetcher{
 		events: []domain.ContributionEvent{
@@ -227,8 +289,10 @@ func TestGeneratorRun_WithCard(t *testing.T) {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
-	if _, ok := gen.Writer.(*fakeWriter).writes["card.svg"]; !ok {
-		t.Fatalf("expected card.svg to be written")
+	for _, name := range []string{"card.svg", "card-heatmap.svg", "card-languages.svg"} {
+		if _, ok := gen.Writer.(*fakeWriter).writes[name]; !ok {
+			t.Fatalf("expected %s to be written", name)
+		}
 	}
 }
 
@@ -277,3 +341,266 @@ func TestGeneratorRun_PropagatesRenderError(t *testing.T) {
 		t.Fatalf("expected render error to be returned")
 	}
 }
+
+func TestGeneratorRun_PassesWindowToFetcher(t *testing.T) {
+	var got domain.TimeWindow
+	window := domain.TimeWindow{Since: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	gen := &Generator{
+		Fetcher:         fakeFetcher{window: &got},
+		Projects:        fakeProjects{},
+		Scorer:          fakeScorer{},
+		ReportRenderer:  &fakeReportRenderer{},
+		SummaryRenderer: &fakeSummaryRenderer{},
+		Writer:          &fakeWriter{},
+		Window:          window,
+	}
+
+	if err := gen.Run(context.Background(), "ray"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != window {
+		t.Fatalf("expected window %+v to be pushed down, got %+v", window, got)
+	}
+}
+
+func TestGeneratorRun_PrivateContributionsAreOptIn(t *testing.T) {
+	reportRenderer := &fakeReportRenderer{}
+	gen := &Generator{
+		Fetcher:         fakeFetcher{},
+		Projects:        fakeProjects{},
+		Scorer:          fakeScorer{},
+		ReportRenderer:  reportRenderer,
+		SummaryRenderer: &fakeSummaryRenderer{},
+		Writer:          &fakeWriter{},
+	}
+	if err := gen.Run(context.Background(), "ray"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if reportRenderer.stats.Private != nil {
+		t.Fatalf("expected no private stats without a source")
+	}
+
+	gen.Private = fakePrivate{private: domain.PrivateContributions{Commits: 3, Restricted: 2}}
+	if err := gen.Run(context.Background(), "ray"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	private := reportRenderer.stats.Private
+	if private == nil || private.Total() != 5 {
+		t.Fatalf("expected private stats with total 5, got %+v", private)
+	}
+	if private.Score != 0 {
+		t.Fatalf("expected private contributions to stay unscored, got %v", private.Score)
+	}
+
+	gen.ScorePrivate = true
+	if err := gen.Run(context.Background(), "ray"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if reportRenderer.stats.Private.Score != 7 {
+		t.Fatalf("expected private contributions to be scored when enabled, got %v", reportRenderer.stats.Private.Score)
+	}
+}
+
+func TestGeneratorRun_WritesUnscoredEventDump(t *testing.T) {
+	writer := &fakeWriter{}
+	gen := &Generator{
+		Fetcher:         fakeFetcher{events: []domain.ContributionEvent{{ID: "1", Type: domain.ContributionTypePR, Repo: "a/b", Stars: 10}}},
+		Projects:        fakeProjects{projects: []domain.OwnedProject{{Repo: "me/owned", Stars: 50}}},
+		Scorer:          fakeScorer{},
+		ReportRenderer:  &fakeReportRenderer{},
+		SummaryRenderer: &fakeSummaryRenderer{},
+		Writer:          writer,
+		Private:         fakePrivate{private: domain.PrivateContributions{Commits: 3}},
+	}
+	if err := gen.Run(context.Background(), "ray"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var dump domain.EventDump
+	if err := json.Unmarshal(writer.writes["events.json"], &dump); err != nil {
+		t.Fatalf("expected events.json to be written: %v", err)
+	}
+	if dump.SchemaVersion != domain.EventDumpVersion || dump.User.Username != "ray" {
+		t.Errorf("unexpected dump header: %+v", dump)
+	}
+	if len(dump.Events) != 1 || dump.Events[0].BaseScore != 0 {
+		t.Errorf("expected the unscored event, got %+v", dump.Events)
+	}
+	if len(dump.Projects) != 1 || dump.Private == nil || dump.Private.Commits != 3 {
+		t.Errorf("expected projects and private stats in the dump, got %+v", dump)
+	}
+	if dump.Scoring.Hash() != scoring.DefaultConfig().Hash() {
+		t.Errorf("expected the scorer's config in the dump")
+	}
+}
+
+func TestGeneratorRender_NeedsNoFetchers(t *testing.T) {
+	reportRenderer := &fakeReportRenderer{}
+	gen := &Generator{
+		Scorer:          fakeScorer{},
+		ReportRenderer:  reportRenderer,
+		SummaryRenderer: &fakeSummaryRenderer{},
+		Writer:          &fakeWriter{},
+	}
+	dump := domain.EventDump{
+		User:   domain.User{Username: "ray"},
+		Events: []domain.ContributionEvent{{ID: "1", Type: domain.ContributionTypePR, Repo: "a/b"}},
+	}
+	if err := gen.Render(context.Background(), dump); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(reportRenderer.projects) != 1 || reportRenderer.projects[0].Score != 42 {
+		t.Fatalf("expected the dump to be scored, got %+v", reportRenderer.projects)
+	}
+	if _, ok := gen.Writer.(*fakeWriter).writes["events.json"]; ok {
+		t.Fatalf("expected Render not to rewrite events.json")
+	}
+}
+
+func TestGeneratorRun_AppliesRepoMetrics(t *testing.T) {
+	writer := &fakeWriter{}
+	gen := &Generator{
+		Fetcher:         fakeFetcher{events: []domain.ContributionEvent{{ID: "1", Type: domain.ContributionTypePR, Repo: "Owner/Lib"}}},
+		Projects:        fakeProjects{projects: []domain.OwnedProject{{Repo: "me/tool"}}},
+		Scorer:          fakeScorer{},
+		ReportRenderer:  &fakeReportRenderer{},
+		SummaryRenderer: &fakeSummaryRenderer{},
+		Writer:          writer,
+		RepoMetrics: map[string]domain.RepoMetrics{
+			"owner/lib": {Dependents: 12},
+			"me/tool":   {Downloads: 500},
+		},
+	}
+	if err := gen.Run(context.Background(), "ray"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var dump domain.EventDump
+	if err := json.Unmarshal(writer.writes["events.json"], &dump); err != nil {
+		t.Fatalf("reading events.json: %v", err)
+	}
+	if dump.Events[0].Dependents != 12 || dump.Projects[0].Downloads != 500 {
+		t.Errorf("expected repo metrics on events and projects, got %+v and %+v", dump.Events[0], dump.Projects[0])
+	}
+}
+
+func TestGeneratorRun_RecordsStarsAtEvent(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	writer := &fakeWriter{}
+	gen := &Generator{
+		Fetcher:         fakeFetcher{events: []domain.ContributionEvent{{ID: "1", Type: domain.ContributionTypePR, Repo: "ext/lib", Stars: 900, CreatedAt: created}}},
+		Projects:        fakeProjects{},
+		Scorer:          fakeScorer{},
+		ReportRenderer:  &fakeReportRenderer{},
+		SummaryRenderer: &fakeSummaryRenderer{},
+		Writer:          writer,
+		StarHistory: &fakeStarHistory{histories: map[string]domain.StarHistory{
+			"ext/lib": {Samples: []domain.StarSample{{At: created, Stars: 30}}},
+		}},
+	}
+	if err := gen.Run(context.Background(), "ray"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var dump domain.EventDump
+	if err := json.Unmarshal(writer.writes["events.json"], &dump); err != nil {
+		t.Fatalf("reading events.json: %v", err)
+	}
+	if got := dump.Events[0].StarsAtEvent; got == nil || *got != 30 {
+		t.Errorf("expected events.json to record 30 stars at the event, got %v", got)
+	}
+}
+
+func TestGeneratorRender_TracksHistory(t *testing.T) {
+	history := &fakeHistory{snapshots: []domain.Snapshot{{
+		TakenAt:     time.Now().Add(-8 * 24 * time.Hour),
+		ScoringHash: scoring.DefaultConfig().Hash(),
+		Score:       10,
+		Stats:       domain.StatsView{PRsOpened: 1},
+		Repos:       map[string]float64{"a/b": 10},
+	}}}
+	reportRenderer := &fakeReportRenderer{}
+	gen := &Generator{
+		Scorer:          fakeScorer{},
+		ReportRenderer:  reportRenderer,
+		SummaryRenderer: &fakeSummaryRenderer{},
+		Writer:          &fakeWriter{},
+		History:         history,
+	}
+	dump := domain.EventDump{
+		User: domain.User{Username: "ray"},
+		Events: []domain.ContributionEvent{
+			{ID: "1", Type: domain.ContributionTypePR, Repo: "a/b"},
+			{ID: "2", Type: domain.ContributionTypePR, Repo: "c/d"},
+		},
+	}
+	if err := gen.Render(context.Background(), dump); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	trend := reportRenderer.insights.Trend
+	if trend == nil {
+		t.Fatalf("expected a trend against the earlier snapshot")
+	}
+	if trend.Stats.PRsOpened != 1 || len(trend.NewRepos) != 1 || trend.NewRepos[0] != "c/d" {
+		t.Errorf("expected one more PR and c/d as a new repo, got %+v", trend)
+	}
+	if trend.ScoringChanged {
+		t.Errorf("expected unchanged scoring to be recognized")
+	}
+
+	if len(history.snapshots) != 2 {
+		t.Fatalf("expected the run to be appended, got %d snapshots", len(history.snapshots))
+	}
+	latest := history.snapshots[1]
+	if latest.Stats.PRsOpened != 2 || len(latest.Repos) != 2 {
+		t.Errorf("expected the new snapshot to record stats and repos, got %+v", latest)
+	}
+}
+
+func TestGeneratorRender_WritesDigest(t *testing.T) {
+	writer := &fakeWriter{}
+	gen := &Generator{
+		Scorer:          fakeScorer{},
+		ReportRenderer:  &fakeReportRenderer{},
+		SummaryRenderer: &fakeSummaryRenderer{},
+		DigestRenderer:  summary.Renderer{},
+		Writer:          writer,
+		History: &fakeHistory{snapshots: []domain.Snapshot{
+			{TakenAt: time.Now().Add(-30 * 24 * time.Hour), Repos: map[string]float64{}},
+			{TakenAt: time.Now().Add(-24 * time.Hour), Repos: map[string]float64{"a/b": 42}},
+		}},
+	}
+	dump := domain.EventDump{
+		User: domain.User{Username: "ray"},
+		Events: []domain.ContributionEvent{
+			{ID: "1", Type: domain.ContributionTypePR, Repo: "a/b"},
+			{ID: "2", Type: domain.ContributionTypePR, Repo: "c/d"},
+		},
+	}
+	if err := gen.Render(context.Background(), dump); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var payload summary.DigestPayload
+	if err := json.Unmarshal(writer.writes["digest.json"], &payload); err != nil {
+		t.Fatalf("reading digest.json: %v", err)
+	}
+	if len(payload.Digest.NewRepos) != 1 || payload.Digest.NewRepos[0] != "c/d" {
+		t.Errorf("expected a digest against the latest snapshot, got %+v", payload.Digest)
+	}
+	if _, ok := writer.writes["digest.md"]; !ok {
+		t.Errorf("expected digest.md to be written")
+	}
+
+	gen.Previous = &domain.Baseline{Repos: map[string]float64{"a/b": 42, "c/d": 42}}
+	if err := gen.Render(context.Background(), dump); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := json.Unmarshal(writer.writes["digest.json"], &payload); err != nil {
+		t.Fatalf("reading digest.json: %v", err)
+	}
+	if len(payload.Digest.NewRepos) != 0 {
+		t.Errorf("expected the previous report to take precedence over history, got %+v", payload.Digest)
+	}
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/arayofcode/footprint/internal/github"
+	"github.com/arayofcode/footprint/internal/logic"
+	"github.com/arayofcode/footprint/internal/render/assets"
+)
+
+// OrgGenerator builds one footprint for every member of an org, team or roster.
+type OrgGenerator struct {
+	Members         domain.MemberDirectory
+	Fetcher         domain.EventFetcher
+	Scorer          domain.ScoreCalculator
+	ReportRenderer  domain.OrgReportRenderer
+	SummaryRenderer domain.OrgSummaryRenderer
+	CardRenderer    domain.OrgCardRenderer
+	Writer          domain.OutputWriter
+	Actions         *github.Actions
+	Window          domain.TimeWindow
+	RepoMetrics     map[string]domain.RepoMetrics // Lowercased repo name to adoption data, for the dependents popularity model
+	StarHistory     domain.StarHistorySource      // When set, popularity uses each event's estimated stars at CreatedAt
+
+	// Leaderboard ranks the top LeaderboardSize members in leaderboard.svg
+	Leaderboard     domain.LeaderboardRenderer
+	LeaderboardSize int
+}
+
+// Run fetches each member in turn and writes org-level report.json,
+// summary.md, card.svg and leaderboard.svg. When roster is empty, members are listed from
+// scope.Org (and scope.Team). A member whose fetch fails is skipped.
+func (g *OrgGenerator) Run(ctx context.Context, scope domain.OrgScope, roster []string) error {
+	if g.Fetcher == nil || g.Scorer == nil || g.ReportRenderer == nil || g.SummaryRenderer == nil || g.Writer == nil {
+		return fmt.Errorf("org generator dependencies are not fully configured")
+	}
+
+	usernames := roster
+	if len(usernames) == 0 {
+		if g.Members == nil || scope.Org == "" {
+			return fmt.Errorf("org mode needs an org or a roster")
+		}
+		var err error
+		usernames, err = g.Members.FetchMembers(ctx, scope.Org, scope.Team)
+		if err != nil {
+			return fmt.Errorf("listing members: %w", err)
+		}
+	}
+	if len(usernames) == 0 {
+		return fmt.Errorf("no members found for %s", scope.Name)
+	}
+
+	var members []domain.MemberActivity
+	for _, username := range usernames {
+		user, events, err := g.Fetcher.FetchExternalContributions(ctx, username, g.Window)
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("fetching contributions for %s: %w", username, err)
+			}
+			fmt.Printf("Warning: skipping member %s: %v\n", username, err)
+			continue
+		}
+		applyRepoMetrics(g.RepoMetrics, events, nil)
+		if err := applyStarHistory(ctx, g.StarHistory, events); err != nil {
+			return fmt.Errorf("estimating stars at contribution time for %s: %w", username, err)
+		}
+		// Score per member so decay matches an individual footprint
+		events = g.Scorer.ScoreBatch(events)
+		members = append(members, domain.MemberActivity{
+			User:   user,
+			Events: logic.MapClassify(events),
+		})
+	}
+
+	footprint := logic.AggregateOrg(scope, members, g.Scorer.Config())
+	generatedAt := time.Now()
+
+	reportJSON, err := g.ReportRenderer.RenderOrgReport(ctx, footprint, generatedAt)
+	if err != nil {
+		return fmt.Errorf("rendering org report: %w", err)
+	}
+
+	summaryMD, err := g.SummaryRenderer.RenderOrgSummary(ctx, footprint, generatedAt)
+	if err != nil {
+		return fmt.Errorf("rendering org summary: %w", err)
+	}
+
+	if err := g.Writer.Write(ctx, "report.json", reportJSON); err != nil {
+		return fmt.Errorf("writing report.json: %w", err)
+	}
+
+	if err := g.Writer.Write(ctx, "summary.md", summaryMD); err != nil {
+		return fmt.Errorf("writing summary.md: %w", err)
+	}
+
+	if g.Actions != nil {
+		if err := g.Actions.WriteSummary(summaryMD); err != nil {
+			fmt.Printf("Warning: failed to write job summary: %v\n", err)
+		}
+
+		g.Actions.SetOutput("total_contributions", fmt.Sprintf("%d", footprint.Events))
+		g.Actions.SetOutput("total_score", fmt.Sprintf("%.2f", footprint.TotalScore))
+		g.Actions.SetOutput("lifetime_score", fmt.Sprintf("%.2f", footprint.LifetimeScore))
+	}
+
+	if g.CardRenderer == nil && g.Leaderboard == nil {
+		return nil
+	}
+
+	memberRows := 0
+	if g.Leaderboard != nil {
+		memberRows = g.LeaderboardSize
+	}
+	assetMap := assets.FetchOrgAssets(footprint, memberRows)
+
+	if g.CardRenderer != nil {
+		cardSVG, err := g.CardRenderer.RenderOrgCard(ctx, footprint, generatedAt, assetMap)
+		if err != nil {
+			return fmt.Errorf("rendering org card: %w", err)
+		}
+		if err := g.Writer.Write(ctx, "card.svg", cardSVG); err != nil {
+			return fmt.Errorf("writing card.svg: %w", err)
+		}
+	}
+
+	if g.Leaderboard != nil {
+		leaderboardSVG, err := g.Leaderboard.RenderLeaderboard(ctx, footprint, generatedAt, assetMap)
+		if err != nil {
+			return fmt.Errorf("rendering leaderboard: %w", err)
+		}
+		if err := g.Writer.Write(ctx, "leaderboard.svg", leaderboardSVG); err != nil {
+			return fmt.Errorf("writing leaderboard.svg: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// readRoster reads one GitHub username per line. Blank lines, "#" comments
+// and a leading "@" are ignored.
+func readRoster(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening roster: %w", err)
+	}
+	defer f.Close() //nolint:errcheck
+
+	var usernames []string
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		username := strings.TrimPrefix(strings.TrimSpace(line), "@")
+		if username == "" || seen[strings.ToLower(username)] {
+			continue
+		}
+		seen[strings.ToLower(username)] = true
+		usernames = append(usernames, username)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading roster: %w", err)
+	}
+	return usernames, nil
+}
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+type fakeMembers struct {
+	members []string
+	org     string
+	team    string
+}
+
+func (f *fakeMembers) FetchMembers(ctx context.Context, org, team string) ([]string, error) {
+	f.org = org
+	f.team = team
+	return f.members, nil
+}
+
+// memberFetcher returns per-member events and fails for unknown members.
+type memberFetcher map[string][]domain.ContributionEvent
+
+func (f memberFetcher) FetchExternalContributions(ctx context.Context, username string, window domain.TimeWindow) (domain.User, []domain.ContributionEvent, error) {
+	events, ok := f[username]
+	if !ok {
+		return domain.User{}, nil, errors.New("user not found")
+	}
+	return domain.User{Username: username}, events, nil
+}
+
+type fakeOrgRenderer struct {
+	org domain.OrgFootprint
+}
+
+func (f *fakeOrgRenderer) RenderOrgReport(ctx context.Context, org domain.OrgFootprint, generatedAt time.Time) ([]byte, error) {
+	f.org = org
+	return []byte("org-report"), nil
+}
+
+func (f *fakeOrgRenderer) RenderOrgSummary(ctx context.Context, org domain.OrgFootprint, generatedAt time.Time) ([]byte, error) {
+	return []byte("org-summary"), nil
+}
+
+func (f *fakeOrgRenderer) RenderLeaderboard(ctx context.Context, org domain.OrgFootprint, generatedAt time.Time, assets map[domain.AssetKey]string) ([]byte, error) {
+	return []byte("leaderboard"), nil
+}
+
+func (f *fakeOrgRenderer) RenderOrgCard(ctx context.Context, org domain.OrgFootprint, generatedAt time.Time, assets map[domain.AssetKey]string) ([]byte, error) {
+	return []byte("org-card"), nil
+}
+
+func TestOrgGeneratorRun_ListsTeamMembersAndSkipsFailures(t *testing.T) {
+	members := &fakeMembers{members: []string{"ana", "bo", "ghost"}}
+	fetcher := memberFetcher{
+		// Both review the same PR; reviews carry the PR's ID
+		"ana": {{ID: "pr1", Type: domain.ContributionTypeReview, Repo: "up/stream"}},
+		"bo":  {{ID: "pr1", Type: domain.ContributionTypeReview, Repo: "up/stream"}, {ID: "i1", Type: domain.ContributionTypeIssue, Repo: "acme/internal"}},
+	}
+	renderer := &fakeOrgRenderer{}
+	writer := &fakeWriter{}
+
+	gen := &OrgGenerator{
+		Members:         members,
+		Fetcher:         fetcher,
+		Scorer:          fakeScorer{},
+		ReportRenderer:  renderer,
+		SummaryRenderer: renderer,
+		CardRenderer:    renderer,
+		Leaderboard:     renderer,
+		Writer:          writer,
+	}
+
+	scope := domain.OrgScope{Org: "acme", Team: "platform", Name: "acme/platform"}
+	if err := gen.Run(context.Background(), scope, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if members.org != "acme" || members.team != "platform" {
+		t.Errorf("expected team members of acme/platform to be listed, got %s/%s", members.org, members.team)
+	}
+	if len(renderer.org.Members) != 2 {
+		t.Fatalf("expected failed member to be skipped, got %d members", len(renderer.org.Members))
+	}
+	if renderer.org.Events != 2 || len(renderer.org.Upstreams) != 1 {
+		t.Errorf("expected both members' reviews in one upstream, got %d events in %d upstreams", renderer.org.Events, len(renderer.org.Upstreams))
+	}
+	for _, name := range []string{"report.json", "summary.md", "card.svg", "leaderboard.svg"} {
+		if _, ok := writer.writes[name]; !ok {
+			t.Errorf("expected %s to be written", name)
+		}
+	}
+}
+
+func TestOrgGeneratorRun_RequiresOrgOrRoster(t *testing.T) {
+	renderer := &fakeOrgRenderer{}
+	gen := &OrgGenerator{
+		Fetcher:         memberFetcher{},
+		Scorer:          fakeScorer{},
+		ReportRenderer:  renderer,
+		SummaryRenderer: renderer,
+		Writer:          &fakeWriter{},
+	}
+
+	if err := gen.Run(context.Background(), domain.OrgScope{Name: "nobody"}, nil); err == nil {
+		t.Fatal("expected error without org or roster")
+	}
+}
+
+func TestReadRoster(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.txt")
+	content := "# platform team\n@ana\nbo  # on leave\n\nANA\ncy\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("writing roster: %v", err)
+	}
+
+	got, err := readRoster(path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []string{"ana", "bo", "cy"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readRoster() = %v, want %v", got, want)
+	}
+}
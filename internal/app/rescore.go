@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/arayofcode/footprint/internal/logic"
+	"github.com/arayofcode/footprint/internal/output"
+	"github.com/arayofcode/footprint/internal/render/card"
+	"github.com/arayofcode/footprint/internal/render/report"
+	"github.com/arayofcode/footprint/internal/render/summary"
+	"github.com/arayofcode/footprint/internal/scoring"
+)
+
+// RunRescore rebuilds every artifact from an events.json written by a
+// previous run, scored with the weights in cfg instead of the original ones.
+// Nothing is fetched from GitHub. It also writes rescore-diff.md comparing
+// repo rankings under the original and the new weights, and prints it.
+func RunRescore(ctx context.Context, cfg CLIConfig, eventsPath string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	dump, err := readEventDump(eventsPath)
+	if err != nil {
+		return err
+	}
+	if cfg.PrivateScore && dump.Private == nil {
+		return fmt.Errorf("private contribution scoring requires private stats in %s", eventsPath)
+	}
+
+	scoringConfig, err := loadScoringConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	outputDir := cfg.OutputDir
+	if outputDir == "" {
+		outputDir = "dist"
+	}
+	writer := output.NewFileSystemWriter(outputDir)
+	calculator := scoring.NewCalculator(scoringConfig)
+	summaryRenderer := summary.Renderer{Window: dump.Window}
+
+	gen := &Generator{
+		Scorer:          calculator,
+		ReportRenderer:  report.Renderer{Scoring: &scoringConfig, Window: dump.Window},
+		SummaryRenderer: summaryRenderer,
+		Writer:          writer,
+		ScorePrivate:    cfg.PrivateScore,
+	}
+	if cfg.EnableCard {
+		gen.CardRenderer = card.Renderer{MinDisplayStars: max(cfg.MinStars, 0), ShowMilestones: cfg.Milestones, ShowLanguages: cfg.Languages, Window: dump.Window}
+	}
+	if err := gen.Render(ctx, dump); err != nil {
+		return fmt.Errorf("rescore failed: %w", err)
+	}
+
+	// Private totals are left out of the comparison: whether they are scored
+	// is a flag, not a weight.
+	before := scoreDump(scoring.NewCalculator(dump.Scoring), dump)
+	after := scoreDump(calculator, dump)
+	diffMD, err := summaryRenderer.RenderRescoreDiff(ctx, dump.User, time.Now(), dump.Scoring, scoringConfig,
+		domain.TotalScores(before.repos, before.projectImpacts, nil),
+		domain.TotalScores(after.repos, after.projectImpacts, nil),
+		logic.DiffRankings(before.repos, after.repos))
+	if err != nil {
+		return fmt.Errorf("rendering rescore diff: %w", err)
+	}
+	if err := writer.Write(ctx, "rescore-diff.md", diffMD); err != nil {
+		return fmt.Errorf("writing rescore-diff.md: %w", err)
+	}
+	fmt.Print(string(diffMD))
+	return nil
+}
+
+func readEventDump(path string) (domain.EventDump, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.EventDump{}, fmt.Errorf("reading events: %w", err)
+	}
+	var dump domain.EventDump
+	if err := json.Unmarshal(data, &dump); err != nil {
+		return domain.EventDump{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if dump.SchemaVersion != domain.EventDumpVersion {
+		return domain.EventDump{}, fmt.Errorf("%s has schema version %q, expected %q", path, dump.SchemaVersion, domain.EventDumpVersion)
+	}
+	if err := scoring.ValidateConfig(dump.Scoring); err != nil {
+		return domain.EventDump{}, fmt.Errorf("%s has invalid original weights: %w", path, err)
+	}
+	return dump, nil
+}
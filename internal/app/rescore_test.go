@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/arayofcode/footprint/internal/scoring"
+)
+
+func writeEventDump(t *testing.T, dump domain.EventDump) string {
+	t.Helper()
+	data, err := json.Marshal(dump)
+	if err != nil {
+		t.Fatalf("marshaling dump: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "events.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("writing dump: %v", err)
+	}
+	return path
+}
+
+func TestRunRescore_RendersWithNewWeights(t *testing.T) {
+	created := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	path := writeEventDump(t, domain.EventDump{
+		SchemaVersion: domain.EventDumpVersion,
+		User:          domain.User{Username: "ray"},
+		Scoring:       scoring.DefaultConfig(),
+		Events: []domain.ContributionEvent{
+			{ID: "pr", Type: domain.ContributionTypePR, Repo: "a/code", URL: "https://github.com/a/code/pull/1", CreatedAt: created},
+			{ID: "r1", Type: domain.ContributionTypeReview, Repo: "b/reviews", URL: "https://github.com/b/reviews/pull/2#r1", CreatedAt: created},
+			{ID: "r2", Type: domain.ContributionTypeReview, Repo: "b/reviews", URL: "https://github.com/b/reviews/pull/3#r2", CreatedAt: created},
+		},
+	})
+	outputDir := t.TempDir()
+
+	err := RunRescore(context.Background(), CLIConfig{OutputDir: outputDir, BaseScores: "REVIEW=20"}, path)
+	if err != nil {
+		t.Fatalf("RunRescore: %v", err)
+	}
+
+	for _, name := range []string{"report.json", "summary.md", "rescore-diff.md"} {
+		if _, err := os.Stat(filepath.Join(outputDir, name)); err != nil {
+			t.Errorf("expected %s to be written: %v", name, err)
+		}
+	}
+	diff, _ := os.ReadFile(filepath.Join(outputDir, "rescore-diff.md"))
+	for _, want := range []string{
+		"`baseScores`",
+		"| `b/reviews` | #2 · 6.0 | #1 · 40.0 | ▲ 1 |",
+		"| `a/code` | #1 · 10.0 | #2 · 10.0 | ▼ 1 |",
+	} {
+		if !strings.Contains(string(diff), want) {
+			t.Errorf("expected diff to contain %q, got:\n%s", want, diff)
+		}
+	}
+}
+
+func TestRunRescore_RejectsBadDumps(t *testing.T) {
+	if err := RunRescore(context.Background(), CLIConfig{OutputDir: t.TempDir()}, filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("expected an error for a missing dump")
+	}
+
+	path := writeEventDump(t, domain.EventDump{SchemaVersion: "0", Scoring: scoring.DefaultConfig()})
+	if err := RunRescore(context.Background(), CLIConfig{OutputDir: t.TempDir()}, path); err == nil || !strings.Contains(err.Error(), "schema version") {
+		t.Errorf("expected a schema version error, got %v", err)
+	}
+
+	path = writeEventDump(t, domain.EventDump{SchemaVersion: domain.EventDumpVersion, Scoring: scoring.DefaultConfig()})
+	err := RunRescore(context.Background(), CLIConfig{OutputDir: t.TempDir(), PrivateScore: true}, path)
+	if err == nil || !strings.Contains(err.Error(), "private stats") {
+		t.Errorf("expected private scoring to require private stats, got %v", err)
+	}
+}
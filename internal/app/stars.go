@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// starHistoryMaxAge is how long a cached star history is reused. Its samples
+// never go stale, but stars given since it was fetched are only extrapolated.
+const starHistoryMaxAge = 30 * 24 * time.Hour
+
+// starHistoryCache keeps sampled star histories in a JSON file keyed by
+// lowercased repo name, so a repo's stargazers are sampled at most once per
+// starHistoryMaxAge across runs.
+type starHistoryCache struct {
+	source  domain.StarHistorySource
+	path    string
+	entries map[string]domain.StarHistory
+	now     func() time.Time
+}
+
+// newStarHistoryCache loads the cache at path. A missing file starts empty.
+func newStarHistoryCache(source domain.StarHistorySource, path string) (*starHistoryCache, error) {
+	c := &starHistoryCache{source: source, path: path, entries: map[string]domain.StarHistory{}, now: time.Now}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading star history cache: %w", err)
+	}
+	if err := json.Unmarshal(data, &c.entries); err != nil {
+		return nil, fmt.Errorf("parsing star history cache %s: %w", path, err)
+	}
+	return c, nil
+}
+
+func (c *starHistoryCache) FetchStarHistory(ctx context.Context, repo string, stars int) (domain.StarHistory, error) {
+	key := strings.ToLower(repo)
+	if h, ok := c.entries[key]; ok && c.now().Sub(h.FetchedAt) < starHistoryMaxAge {
+		return h, nil
+	}
+	h, err := c.source.FetchStarHistory(ctx, repo, stars)
+	if err != nil {
+		return domain.StarHistory{}, err
+	}
+	c.entries[key] = h
+	if err := c.save(); err != nil {
+		fmt.Printf("Warning: failed to save star history cache: %v\n", err)
+	}
+	return h, nil
+}
+
+func (c *starHistoryCache) save() error {
+	data, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0o644)
+}
+
+// defaultStarCachePath is the star history cache used when -star-cache is
+// not set: footprint/star-history.json in the user's cache directory.
+func defaultStarCachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = ".cache"
+	}
+	return filepath.Join(dir, "footprint", "star-history.json")
+}
+
+// applyStarHistory estimates each event's StarsAtEvent from its repo's star
+// history, fetched once per repo. Repos without stars are skipped, and repos
+// whose history cannot be fetched keep today's count.
+func applyStarHistory(ctx context.Context, source domain.StarHistorySource, events []domain.ContributionEvent) error {
+	if source == nil {
+		return nil
+	}
+	histories := make(map[string]*domain.StarHistory)
+	for i := range events {
+		e := &events[i]
+		if e.Stars <= 0 {
+			continue
+		}
+		h, ok := histories[e.Repo]
+		if !ok {
+			fetched, err := source.FetchStarHistory(ctx, e.Repo, e.Stars)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				fmt.Printf("Warning: no star history for %s: %v\n", e.Repo, err)
+			} else {
+				h = &fetched
+			}
+			histories[e.Repo] = h
+		}
+		if h != nil {
+			stars := h.StarsAt(e.CreatedAt)
+			e.StarsAtEvent = &stars
+		}
+	}
+	return nil
+}
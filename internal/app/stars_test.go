@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+type fakeStarHistory struct {
+	histories map[string]domain.StarHistory
+	calls     map[string]int
+}
+
+func (f *fakeStarHistory) FetchStarHistory(ctx context.Context, repo string, stars int) (domain.StarHistory, error) {
+	if f.calls == nil {
+		f.calls = map[string]int{}
+	}
+	f.calls[repo]++
+	h, ok := f.histories[repo]
+	if !ok {
+		return domain.StarHistory{}, errors.New("not found")
+	}
+	return h, nil
+}
+
+func TestApplyStarHistory(t *testing.T) {
+	jan, jul := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	source := &fakeStarHistory{histories: map[string]domain.StarHistory{
+		"ext/lib": {Samples: []domain.StarSample{{At: jan, Stars: 1}, {At: jul, Stars: 5000}}},
+	}}
+	events := []domain.ContributionEvent{
+		{Repo: "ext/lib", Stars: 5000, CreatedAt: jan},
+		{Repo: "ext/lib", Stars: 5000, CreatedAt: jul},
+		{Repo: "ext/gone", Stars: 10, CreatedAt: jan},
+		{Repo: "ext/new", CreatedAt: jan},
+	}
+
+	if err := applyStarHistory(context.Background(), source, events); err != nil {
+		t.Fatalf("applyStarHistory: %v", err)
+	}
+	if events[0].StarsAtEvent == nil || *events[0].StarsAtEvent != 1 || *events[1].StarsAtEvent != 5000 {
+		t.Errorf("expected stars estimated at each event, got %v and %v", events[0].StarsAtEvent, events[1].StarsAtEvent)
+	}
+	if events[2].StarsAtEvent != nil || events[3].StarsAtEvent != nil {
+		t.Errorf("expected repos without history or stars to keep today's count")
+	}
+	if source.calls["ext/lib"] != 1 || source.calls["ext/gone"] != 1 || source.calls["ext/new"] != 0 {
+		t.Errorf("expected one fetch per starred repo, got %v", source.calls)
+	}
+}
+
+func TestStarHistoryCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "stars.json")
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	source := &fakeStarHistory{histories: map[string]domain.StarHistory{
+		"Ext/Lib": {FetchedAt: now, Samples: []domain.StarSample{{At: now, Stars: 42}}},
+	}}
+
+	cache, err := newStarHistoryCache(source, path)
+	if err != nil {
+		t.Fatalf("newStarHistoryCache: %v", err)
+	}
+	cache.now = func() time.Time { return now }
+	if _, err := cache.FetchStarHistory(context.Background(), "Ext/Lib", 42); err != nil {
+		t.Fatalf("FetchStarHistory: %v", err)
+	}
+
+	// A second run reads the file instead of resampling
+	reloaded, err := newStarHistoryCache(source, path)
+	if err != nil {
+		t.Fatalf("reloading cache: %v", err)
+	}
+	reloaded.now = func() time.Time { return now.Add(24 * time.Hour) }
+	h, err := reloaded.FetchStarHistory(context.Background(), "ext/lib", 42)
+	if err != nil || h.StarsAt(now) != 42 {
+		t.Fatalf("expected the cached history, got %+v, %v", h, err)
+	}
+	if source.calls["Ext/Lib"] != 1 {
+		t.Errorf("expected one fetch, got %d", source.calls["Ext/Lib"])
+	}
+
+	reloaded.now = func() time.Time { return now.Add(starHistoryMaxAge) }
+	if _, err := reloaded.FetchStarHistory(context.Background(), "Ext/Lib", 42); err != nil {
+		t.Fatalf("refetching: %v", err)
+	}
+	if source.calls["Ext/Lib"] != 2 {
+		t.Errorf("expected an expired entry to be refetched, got %d fetches", source.calls["Ext/Lib"])
+	}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import "time"
+
+// CommunityScope identifies the owned repos a community report covers.
+type CommunityScope struct {
+	Owner string   // Maintainer whose own activity is not counted as community
+	Repos []string // Owned repos, as owner/name
+}
+
+// RepoActivity is what happened in one owned repo, grouped by author.
+type RepoActivity struct {
+	Repo         string
+	Contributors []ContributorActivity
+	PullRequests []PullRequestActivity
+}
+
+// ContributorActivity is one author's raw events in a repo.
+type ContributorActivity struct {
+	User   User
+	Events []ContributionEvent
+}
+
+// PullRequestActivity is the review history of one pull request.
+type PullRequestActivity struct {
+	Repo      string
+	Author    string
+	CreatedAt time.Time
+	Merged    bool
+	Reviews   []PullRequestReview
+}
+
+// PullRequestReview is one submitted review on a pull request.
+type PullRequestReview struct {
+	Reviewer  User
+	CreatedAt time.Time
+}
+
+// CommunityContributor is one external contributor's share of a community.
+type CommunityContributor struct {
+	MemberFootprint
+	FirstContribution time.Time // Earliest fetched event
+}
+
+// PeriodCount counts occurrences in a calendar period, e.g. "2025-01".
+type PeriodCount struct {
+	Period string
+	Count  int
+}
+
+// ReviewerLoad is how many reviews one person submitted on others' PRs.
+type ReviewerLoad struct {
+	User    User
+	Reviews int
+	Share   float64 // Fraction of all reviews
+}
+
+// ReviewLoad summarizes who reviews pull requests and how quickly.
+type ReviewLoad struct {
+	PullRequests       int           // PRs opened, by anyone
+	Reviewed           int           // PRs reviewed by someone other than the author
+	Reviews            int           // Reviews on other people's PRs
+	MedianFirstReview  time.Duration // Zero when nothing was reviewed
+	Reviewers          []ReviewerLoad
+	MaintainerReviews  int // Reviews submitted by the owner
+	CommunityReviewers int // Distinct reviewers other than the owner
+}
+
+// BusFactor counts the fewest people that account for half of the work.
+// Low values mean the project depends on a handful of people.
+type BusFactor struct {
+	Authors          int     // Of merged PRs
+	Reviewers        int     // Of reviews
+	TopAuthorShare   float64 // Fraction of merged PRs by the top author
+	TopReviewerShare float64 // Fraction of reviews by the top reviewer
+}
+
+// CommunityFootprint describes who contributes to a maintainer's own repos.
+// Contributors excludes the owner; review load and bus factor include them.
+type CommunityFootprint struct {
+	Scope         CommunityScope
+	Stats         StatsView
+	TotalScore    float64
+	LifetimeScore float64 // TotalScore without recency weighting
+	Events        int
+	Repos         []RepoContribution
+	Contributors  []CommunityContributor // By score, highest first
+	FirstTimers   []PeriodCount          // Contributors GitHub flagged as first-time, per month, oldest first
+	Reviews       ReviewLoad
+	BusFactor     BusFactor
+}
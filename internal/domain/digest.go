@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+)
+
+// Baseline is what the previous run saw, read from its report.json or its
+// history snapshot, for a digest of what changed since.
+type Baseline struct {
+	TakenAt      time.Time
+	Score        float64
+	Repos        map[string]float64 // Score per external repo and owned project
+	ProjectStars map[string]int     // Stars per owned project; nil when unknown
+	MergedPRs    map[string]bool    // URLs of merged PRs; nil when unknown
+}
+
+// Digest is what changed since the previous run, short enough for a chat
+// channel.
+type Digest struct {
+	Since      time.Time   `json:"since,omitzero"` // Zero on the first run
+	MergedPRs  []DigestPR  `json:"mergedPRs"`
+	NewRepos   []string    `json:"newRepos"` // External repos contributed to for the first time
+	NewStars   []StarGain  `json:"newStars"` // Owned projects that gained stars
+	Score      float64     `json:"score"`
+	ScoreDelta float64     `json:"scoreDelta"`
+	Repos      []RepoDelta `json:"repos"` // Score changes of repos scored before, largest first
+}
+
+// IsEmpty reports whether nothing changed worth mentioning.
+func (d Digest) IsEmpty() bool {
+	return len(d.MergedPRs) == 0 && len(d.NewRepos) == 0 && len(d.NewStars) == 0 && len(d.Repos) == 0
+}
+
+// DigestPR is a pull request merged since the previous run.
+type DigestPR struct {
+	Repo      string    `json:"repo"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"createdAt"`
+	MergedAt  time.Time `json:"mergedAt"`
+}
+
+// StarGain is an owned project's stars now and how many it gained.
+type StarGain struct {
+	Repo   string `json:"repo"`
+	URL    string `json:"url"`
+	Stars  int    `json:"stars"`
+	Gained int    `json:"gained"`
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+)
+
+// EventDumpVersion is the current events.json schema version.
+const EventDumpVersion = "1"
+
+// EventDump is everything a personal footprint was built from, before
+// scoring. It is written as events.json so the footprint can be rescored
+// offline with different weights.
+type EventDump struct {
+	SchemaVersion string                `json:"schema_version"`
+	FetchedAt     time.Time             `json:"fetched_at"`
+	User          User                  `json:"user"`
+	Window        TimeWindow            `json:"window,omitzero"`
+	Scoring       ScoringConfig         `json:"scoring"` // Weights the footprint was originally scored with
+	Events        []ContributionEvent   `json:"events"`
+	Projects      []OwnedProject        `json:"projects"`
+	Private       *PrivateContributions `json:"private,omitempty"` // Unscored; set when private stats were fetched
+}
+
+// RankChange is one repo's position under two scoring configs. Ranks start
+// at 1.
+type RankChange struct {
+	Repo        string
+	BeforeRank  int
+	AfterRank   int
+	BeforeScore float64
+	AfterScore  float64
+}
+
+// Moved is how many places the repo climbed; negative when it fell.
+func (c RankChange) Moved() int {
+	return c.BeforeRank - c.AfterRank
+}
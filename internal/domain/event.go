@@ -17,24 +17,122 @@ const (
 	ContributionTypeReviewComment     ContributionType = "REVIEW_COMMENT"
 	ContributionTypeDiscussion        ContributionType = "DISCUSSION"
 	ContributionTypeDiscussionComment ContributionType = "DISCUSSION_COMMENT"
+	ContributionTypeSecurityAdvisory  ContributionType = "SECURITY_ADVISORY" // Credited on a published GHSA
+	ContributionTypeRelease           ContributionType = "RELEASE"           // Published in a non-owned repo
+	ContributionTypeTriage            ContributionType = "TRIAGE"            // Maintainer timeline action
 )
 
+// TriageAction is the maintainer action behind a TRIAGE contribution.
+type TriageAction string
+
+const (
+	TriageLabeled           TriageAction = "LABELED"
+	TriageClosed            TriageAction = "CLOSED"
+	TriageMarkedAsDuplicate TriageAction = "MARKED_AS_DUPLICATE"
+	TriageReviewRequested   TriageAction = "REVIEW_REQUESTED"
+)
+
+// ReactionContent mirrors GitHub's ReactionContent enum.
+type ReactionContent string
+
+const (
+	ReactionThumbsUp   ReactionContent = "THUMBS_UP"
+	ReactionThumbsDown ReactionContent = "THUMBS_DOWN"
+	ReactionLaugh      ReactionContent = "LAUGH"
+	ReactionHooray     ReactionContent = "HOORAY"
+	ReactionConfused   ReactionContent = "CONFUSED"
+	ReactionHeart      ReactionContent = "HEART"
+	ReactionRocket     ReactionContent = "ROCKET"
+	ReactionEyes       ReactionContent = "EYES"
+)
+
+// ReactionContents lists every reaction in GitHub's display order.
+var ReactionContents = []ReactionContent{
+	ReactionThumbsUp,
+	ReactionThumbsDown,
+	ReactionLaugh,
+	ReactionHooray,
+	ReactionConfused,
+	ReactionHeart,
+	ReactionRocket,
+	ReactionEyes,
+}
+
 type ContributionEvent struct {
-	ID                 string           `json:"id"`
-	Type               ContributionType `json:"type"`
-	Repo               string           `json:"repo"`
-	RepoOwnerAvatarURL string           `json:"repo_owner_avatar_url,omitempty"`
-	URL                string           `json:"url"`
-	Title              string           `json:"title,omitempty"`
-	CreatedAt          time.Time        `json:"created_at"`
-	Stars              int              `json:"stars,omitempty"`
-	Forks              int              `json:"forks,omitempty"`
-	Merged             bool             `json:"is_merged,omitempty"`
-	Answer             bool             `json:"is_answer,omitempty"`
-	Snippet            string           `json:"snippet,omitempty"`
-	ReactionsCount     int              `json:"reactions_count,omitempty"`
-	BaseScore          float64          `json:"base_score,omitempty"`
-	PopularityRaw      float64          `json:"popularity_raw,omitempty"`
+	ID                 string                  `json:"id"`
+	Type               ContributionType        `json:"type"`
+	Repo               string                  `json:"repo"`
+	RepoOwnerAvatarURL string                  `json:"repo_owner_avatar_url,omitempty"`
+	Language           string                  `json:"language,omitempty"`       // Repo's primary language, as detected by linguist
+	LanguageColor      string                  `json:"language_color,omitempty"` // Linguist color, e.g. "#00ADD8"
+	URL                string                  `json:"url"`
+	Title              string                  `json:"title,omitempty"`
+	Labels             []string                `json:"labels,omitempty"` // Of the PR or issue the event belongs to
+	CreatedAt          time.Time               `json:"created_at"`
+	Stars              int                     `json:"stars,omitempty"`
+	Forks              int                     `json:"forks,omitempty"`
+	StarsAtEvent       *int                    `json:"stars_at_event,omitempty"` // Estimated from stargazer history, when fetched
+	Dependents         int                     `json:"dependents,omitempty"`     // From RepoMetrics, when known
+	Downloads          int                     `json:"downloads,omitempty"`
+	Additions          int                     `json:"additions,omitempty"` // Lines, for PRs
+	Deletions          int                     `json:"deletions,omitempty"`
+	ChangedFiles       int                     `json:"changed_files,omitempty"`
+	Files              []string                `json:"files,omitempty"` // Paths of a PR's first changed files
+	Merged             bool                    `json:"is_merged,omitempty"`
+	MergedAt           time.Time               `json:"merged_at,omitzero"`
+	AuthorAssociation  string                  `json:"author_association,omitempty"`
+	Answer             bool                    `json:"is_answer,omitempty"`
+	Snippet            string                  `json:"snippet,omitempty"`
+	ReactionsCount     int                     `json:"reactions_count,omitempty"` // Total across all reaction types
+	Reactions          map[ReactionContent]int `json:"reactions,omitempty"`
+	ResolvedIssues     []LinkedIssue           `json:"resolved_issues,omitempty"`
+	Advisory           *AdvisoryCredit         `json:"advisory,omitempty"`
+	TriageAction       TriageAction            `json:"triage_action,omitempty"`
+	Trivial            []TrivialReason         `json:"trivial,omitempty"` // Set by scoring when a PR is flagged trivial
+	BaseScore          float64                 `json:"base_score,omitempty"`
+	PopularityRaw      float64                 `json:"popularity_raw,omitempty"`
+	RecencyFactor      float64                 `json:"recency_factor,omitempty"` // Set only when recency weighting is enabled
+	Breakdown          *ScoreBreakdown         `json:"breakdown,omitempty"`
+}
+
+// LinkedIssue is an issue that a pull request closes through a closing keyword
+// (GitHub's closingIssuesReferences).
+type LinkedIssue struct {
+	Repo           string    `json:"repo"`
+	Title          string    `json:"title"`
+	URL            string    `json:"url"`
+	CreatedAt      time.Time `json:"created_at"`
+	ClosedAt       time.Time `json:"closed_at,omitzero"`
+	ReactionsCount int       `json:"reactions_count,omitempty"`
+}
+
+// OpenFor reports how long the issue had been open when the resolving
+// pull request was created. Issues filed after the PR count as zero.
+func (i LinkedIssue) OpenFor(prCreatedAt time.Time) time.Duration {
+	age := prCreatedAt.Sub(i.CreatedAt)
+	if age < 0 {
+		return 0
+	}
+	return age
+}
+
+// AdvisorySeverity mirrors the severity levels of GitHub Security Advisories.
+type AdvisorySeverity string
+
+const (
+	AdvisorySeverityCritical AdvisorySeverity = "CRITICAL"
+	AdvisorySeverityHigh     AdvisorySeverity = "HIGH"
+	AdvisorySeverityMedium   AdvisorySeverity = "MEDIUM"
+	AdvisorySeverityLow      AdvisorySeverity = "LOW"
+)
+
+// AdvisoryCredit describes the user's credit on a published security advisory.
+type AdvisoryCredit struct {
+	GHSAID     string           `json:"ghsa_id"`
+	CVEID      string           `json:"cve_id,omitempty"`
+	Severity   AdvisorySeverity `json:"severity,omitempty"`
+	Ecosystems []string         `json:"ecosystems,omitempty"`
+	CreditType string           `json:"credit_type"` // e.g. reporter, remediation_developer, finder
 }
 
 // Deprecated: Use StatsView instead.
@@ -49,11 +147,22 @@ type UserStats struct {
 }
 
 type OwnedProject struct {
-	Repo      string `json:"repo"`
-	URL       string `json:"url"`
-	AvatarURL string `json:"avatar_url"`
-	Stars     int    `json:"stars"`
-	Forks     int    `json:"forks"`
+	Repo          string `json:"repo"`
+	URL           string `json:"url"`
+	AvatarURL     string `json:"avatar_url"`
+	Language      string `json:"language,omitempty"` // Primary language, as detected by linguist
+	LanguageColor string `json:"language_color,omitempty"`
+	Stars         int    `json:"stars"`
+	Forks         int    `json:"forks"`
+	Dependents    int    `json:"dependents,omitempty"` // From RepoMetrics, when known
+	Downloads     int    `json:"downloads,omitempty"`
+}
+
+// RepoMetrics is adoption data about a repo that GitHub's API doesn't
+// expose, supplied by the user for the dependents popularity model.
+type RepoMetrics struct {
+	Dependents int `json:"dependents"` // Packages or repos depending on it
+	Downloads  int `json:"downloads"`  // Package downloads per month
 }
 
 type EnrichedProject struct {
@@ -73,7 +182,18 @@ func (e ContributionEvent) StableID() string {
 }
 
 func (e ContributionEvent) PopularityMultiplier() float64 {
-	return popularityMultiplier(e.Stars, e.Forks)
+	return popularityMultiplier(e.PopularityStars())
+}
+
+// PopularityStars is the star and fork count the event's popularity is
+// measured by: today's, or the estimate at CreatedAt when StarsAtEvent is
+// set. Forks have no history, so they shrink by the same ratio as stars.
+func (e ContributionEvent) PopularityStars() (stars, forks int) {
+	if e.StarsAtEvent == nil || e.Stars <= 0 {
+		return e.Stars, e.Forks
+	}
+	stars = min(*e.StarsAtEvent, e.Stars)
+	return stars, e.Forks * stars / e.Stars
 }
 
 func (p OwnedProject) PopularityMultiplier() float64 {
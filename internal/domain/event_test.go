@@ -31,3 +31,21 @@ func TestStableID(t *testing.T) {
 		t.Errorf("expected generated ID %s, got %s", expected, e2.StableID())
 	}
 }
+
+func TestPopularityStars_UsesStarsAtEvent(t *testing.T) {
+	then := 250
+	event := ContributionEvent{Stars: 1000, Forks: 100, StarsAtEvent: &then}
+
+	stars, forks := event.PopularityStars()
+	if stars != 250 || forks != 25 {
+		t.Errorf("expected 250 stars and 25 forks at the event, got %d and %d", stars, forks)
+	}
+	if got, want := event.PopularityMultiplier(), popularityMultiplier(250, 25); got != want {
+		t.Errorf("expected multiplier %v, got %v", want, got)
+	}
+
+	event.StarsAtEvent = nil
+	if stars, forks := event.PopularityStars(); stars != 1000 || forks != 100 {
+		t.Errorf("expected today's counts without an estimate, got %d and %d", stars, forks)
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"time"
+)
+
+// Snapshot is one run's footprint, appended to history.jsonl so later runs
+// can show how it changed.
+type Snapshot struct {
+	TakenAt       time.Time          `json:"taken_at"`
+	ScoringHash   string             `json:"scoring_hash,omitempty"` // Weights the scores were computed with
+	Score         float64            `json:"score"`
+	LifetimeScore float64            `json:"lifetime_score"`
+	Stats         StatsView          `json:"stats"`
+	Repos         map[string]float64 `json:"repos"`                   // Score per external repo and owned project
+	ProjectStars  map[string]int     `json:"project_stars,omitempty"` // Stars per owned project
+}
+
+// Trend is how a footprint changed since an earlier snapshot.
+type Trend struct {
+	Since          time.Time   `json:"since"` // When the compared snapshot was taken
+	Score          float64     `json:"score"`
+	LifetimeScore  float64     `json:"lifetimeScore"`
+	Stats          StatsView   `json:"stats"`              // Change in each stat, negative when it fell
+	NewRepos       []string    `json:"newRepos,omitempty"` // Repos with a score now but none then
+	Repos          []RepoDelta `json:"repos,omitempty"`    // Score changes of the other repos, largest first
+	ScoringChanged bool        `json:"scoringChanged,omitempty"`
+}
+
+// RepoDelta is the change in one repo's score.
+type RepoDelta struct {
+	Repo  string  `json:"repo"`
+	Score float64 `json:"score"`
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+)
+
+// Insights carries derived, presentation-only facts about a footprint.
+// Nothing in Insights feeds back into scoring.
+type Insights struct {
+	Milestones []Milestone
+	Trend      *Trend     // Change since an earlier run; nil without history
+	Activity   TimeSeries // Counts and scores by day, week and month
+	Languages  []LanguageShare
+}
+
+// LanguageShare is the part of a footprint's score earned in repos whose
+// primary language is Language.
+type LanguageShare struct {
+	Language string  `json:"language"`
+	Color    string  `json:"color,omitempty"` // Linguist color
+	Score    float64 `json:"score"`
+	Share    float64 `json:"share"` // Fraction of the score of all repos with a known language
+	Repos    int     `json:"repos"`
+}
+
+type MilestoneKind string
+
+const (
+	MilestoneFirstMergedPR    MilestoneKind = "FIRST_MERGED_PR"
+	MilestoneFirstPopularRepo MilestoneKind = "FIRST_POPULAR_REPO"
+	MilestoneNewReposThisYear MilestoneKind = "NEW_REPOS_THIS_YEAR"
+)
+
+// Milestone highlights a notable "first" in a contributor's history.
+type Milestone struct {
+	Kind       MilestoneKind `json:"kind"`
+	Repo       string        `json:"repo,omitempty"`
+	URL        string        `json:"url,omitempty"`
+	Title      string        `json:"title,omitempty"`
+	AchievedAt time.Time     `json:"achievedAt"`
+	Stars      int           `json:"stars,omitempty"`
+	Threshold  int           `json:"threshold,omitempty"` // Star tier crossed (FIRST_POPULAR_REPO)
+	Count      int           `json:"count,omitempty"`     // New repos (NEW_REPOS_THIS_YEAR)
+	Year       int           `json:"year,omitempty"`      // Calendar year (NEW_REPOS_THIS_YEAR)
+	FirstTimer bool          `json:"firstTimeContributor,omitempty"`
+}
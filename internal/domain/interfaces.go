@@ -5,12 +5,20 @@ import (
 	"time"
 )
 
+// EventFetcher lists a user's public contributions created within window.
 type EventFetcher interface {
-	FetchExternalContributions(ctx context.Context, username string) (User, []ContributionEvent, error)
+	FetchExternalContributions(ctx context.Context, username string, window TimeWindow) (User, []ContributionEvent, error)
 }
 
 type ContributionStrategy interface {
-	Fetch(ctx context.Context, username string) ([]ContributionEvent, error)
+	Fetch(ctx context.Context, username string, window TimeWindow) ([]ContributionEvent, error)
+	Name() ContributionType
+}
+
+// RepoScopedStrategy discovers contributions that GitHub search cannot find by
+// user, by inspecting repositories the user is already known to be active in.
+type RepoScopedStrategy interface {
+	FetchForRepos(ctx context.Context, username string, repos []string, window TimeWindow) ([]ContributionEvent, error)
 	Name() ContributionType
 }
 
@@ -18,10 +26,40 @@ type ProjectCatalog interface {
 	FetchOwnedProjects(ctx context.Context, username string) ([]OwnedProject, error)
 }
 
+// PrivateActivitySource reports aggregate-only activity in private repositories.
+type PrivateActivitySource interface {
+	FetchPrivateContributions(ctx context.Context, username string, window TimeWindow) (PrivateContributions, error)
+}
+
+// MemberDirectory lists the usernames in an organization or team.
+type MemberDirectory interface {
+	FetchMembers(ctx context.Context, org, team string) ([]string, error)
+}
+
+// RepoActivitySource reports everyone's activity in a repository.
+type RepoActivitySource interface {
+	FetchRepoActivity(ctx context.Context, repo string, window TimeWindow) (RepoActivity, error)
+}
+
+// StarHistorySource samples when a repo's stars were given. stars is the
+// current count, which bounds how much history there is to sample.
+type StarHistorySource interface {
+	FetchStarHistory(ctx context.Context, repo string, stars int) (StarHistory, error)
+}
+
+// HistoryStore keeps the snapshots of earlier runs, oldest first.
+type HistoryStore interface {
+	LoadHistory(ctx context.Context) ([]Snapshot, error)
+	AppendSnapshot(ctx context.Context, snapshot Snapshot) error
+}
+
 type ScoreCalculator interface {
 	ScoreContribution(event ContributionEvent) ContributionEvent
 	ScoreBatch(events []ContributionEvent) []ContributionEvent
 	EnrichOwnedProject(project OwnedProject) EnrichedProject
+	EnrichOwnedProjects(projects []OwnedProject) []EnrichedProject
+	ScorePrivate(private PrivateContributions) PrivateContributions
+	Config() ScoringConfig
 }
 
 type User struct {
@@ -35,18 +73,55 @@ type User struct {
 }
 
 type ReportRenderer interface {
-	RenderReport(ctx context.Context, user User, stats StatsView, generatedAt time.Time, projects []RepoContribution, ownedProjects []OwnedProjectImpact) ([]byte, error)
+	RenderReport(ctx context.Context, user User, stats StatsView, generatedAt time.Time, projects []RepoContribution, ownedProjects []OwnedProjectImpact, insights Insights) ([]byte, error)
 }
 
 type SummaryRenderer interface {
-	RenderSummary(ctx context.Context, user User, stats StatsView, generatedAt time.Time, projects []RepoContribution, ownedProjects []OwnedProjectImpact) ([]byte, error)
+	RenderSummary(ctx context.Context, user User, stats StatsView, generatedAt time.Time, projects []RepoContribution, ownedProjects []OwnedProjectImpact, insights Insights) ([]byte, error)
 }
 
 type CardRenderer interface {
-	RenderCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, assets map[AssetKey]string) ([]byte, error)
-	RenderMinimalCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, assets map[AssetKey]string) ([]byte, error)
-	RenderExtendedCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, assets map[AssetKey]string) ([]byte, error)
-	RenderExtendedMinimalCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, assets map[AssetKey]string) ([]byte, error)
+	RenderCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, insights Insights, assets map[AssetKey]string) ([]byte, error)
+	RenderMinimalCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, insights Insights, assets map[AssetKey]string) ([]byte, error)
+	RenderExtendedCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, insights Insights, assets map[AssetKey]string) ([]byte, error)
+	RenderExtendedMinimalCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, insights Insights, assets map[AssetKey]string) ([]byte, error)
+	RenderHeatmapCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, insights Insights, assets map[AssetKey]string) ([]byte, error)
+	RenderLanguageCard(ctx context.Context, user User, stats StatsView, generatedAt time.Time, contributions []RepoContribution, projects []OwnedProjectImpact, insights Insights, assets map[AssetKey]string) ([]byte, error)
+}
+
+// DigestRenderer renders what changed since the previous run, as Markdown
+// and as a chat webhook payload.
+type DigestRenderer interface {
+	RenderDigest(ctx context.Context, user User, generatedAt time.Time, digest Digest) ([]byte, error)
+	RenderDigestPayload(ctx context.Context, user User, generatedAt time.Time, digest Digest) ([]byte, error)
+}
+
+type OrgReportRenderer interface {
+	RenderOrgReport(ctx context.Context, org OrgFootprint, generatedAt time.Time) ([]byte, error)
+}
+
+type OrgSummaryRenderer interface {
+	RenderOrgSummary(ctx context.Context, org OrgFootprint, generatedAt time.Time) ([]byte, error)
+}
+
+type OrgCardRenderer interface {
+	RenderOrgCard(ctx context.Context, org OrgFootprint, generatedAt time.Time, assets map[AssetKey]string) ([]byte, error)
+}
+
+type LeaderboardRenderer interface {
+	RenderLeaderboard(ctx context.Context, org OrgFootprint, generatedAt time.Time, assets map[AssetKey]string) ([]byte, error)
+}
+
+type CommunityReportRenderer interface {
+	RenderCommunityReport(ctx context.Context, community CommunityFootprint, generatedAt time.Time) ([]byte, error)
+}
+
+type CommunitySummaryRenderer interface {
+	RenderCommunitySummary(ctx context.Context, community CommunityFootprint, generatedAt time.Time) ([]byte, error)
+}
+
+type CommunityCardRenderer interface {
+	RenderCommunityCard(ctx context.Context, community CommunityFootprint, generatedAt time.Time, assets map[AssetKey]string) ([]byte, error)
 }
 
 type OutputWriter interface {
@@ -0,0 +1,49 @@
+package domain
+
+// OrgScope identifies the group of people an org footprint covers.
+type OrgScope struct {
+	Org  string // Organization login. Repos it owns are not external.
+	Team string // Team slug within Org. Empty for the whole org.
+	Name string // Display name, e.g. "acme" or "acme/platform"
+}
+
+// Handle is the GitHub account that represents the scope on cards.
+func (s OrgScope) Handle() string {
+	if s.Org != "" {
+		return s.Org
+	}
+	return s.Name
+}
+
+// MemberActivity is one member's classified, scored events.
+type MemberActivity struct {
+	User   User
+	Events []SemanticEvent
+}
+
+// MemberFootprint is one member's share of an org footprint.
+type MemberFootprint struct {
+	User  User
+	Stats StatsView
+	Score float64
+	Repos []RepoContribution
+}
+
+// UpstreamImpact is the deduplicated org-wide impact on one external repo.
+type UpstreamImpact struct {
+	RepoContribution
+	Members []string // Usernames that contributed, sorted
+}
+
+// OrgFootprint aggregates the external contributions of every member.
+// Stats and Upstreams count an event once even if several members' fetches
+// returned it; Members keep each person's own view.
+type OrgFootprint struct {
+	Scope         OrgScope
+	Stats         StatsView
+	TotalScore    float64
+	LifetimeScore float64 // TotalScore without recency weighting
+	Events        int     // Deduplicated external events
+	Members       []MemberFootprint
+	Upstreams     []UpstreamImpact
+}
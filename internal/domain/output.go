@@ -9,8 +9,12 @@ type RepoContribution struct {
 	Repo               string
 	RepoURL            string
 	AvatarURL          string
+	Language           string // Primary language, empty when unknown
+	LanguageColor      string
 	Score              float64 // Final weighted score
+	LifetimeScore      float64 // Score without recency weighting
 	BaseScore          float64 // Sum of per-event base scores
+	ReleaseScore       float64 // Releases, already scaled by their own popularity
 	PopularityRaw      float64 // Peak popularity raw
 	PRsOpened          int
 	PRReviews          int
@@ -20,6 +24,10 @@ type RepoContribution struct {
 	DiscussionsOpened  int
 	DiscussionComments int
 	Commits            int
+	SecurityAdvisories int
+	Releases           int
+	TriageActions      int
+	TrivialPRs         int            // PRs flagged trivial, scored at a reduced base
 	Events             []Contribution // Finalized output contributions
 }
 
@@ -35,6 +43,9 @@ const (
 	ContributionDiscussion        FinalizedContributionType = "DISCUSSION"
 	ContributionDiscussionComment FinalizedContributionType = "DISCUSSION_COMMENT"
 	ContributionCommit            FinalizedContributionType = "COMMIT"
+	ContributionSecurityAdvisory  FinalizedContributionType = "SECURITY_ADVISORY"
+	ContributionRelease           FinalizedContributionType = "RELEASE"
+	ContributionTriage            FinalizedContributionType = "TRIAGE"
 	ContributionUnknown           FinalizedContributionType = "UNKNOWN"
 )
 
@@ -46,7 +57,15 @@ type Contribution struct {
 	URL            string
 	CreatedAt      time.Time
 	ReactionsCount int
+	Reactions      map[ReactionContent]int `json:",omitempty"`
 	Merged         bool
+	MergedAt       time.Time       `json:",omitzero"`
+	ResolvedIssues []LinkedIssue   `json:",omitempty"`
+	Advisory       *AdvisoryCredit `json:",omitempty"`
+	TriageAction   TriageAction    `json:",omitempty"`
+	RecencyFactor  float64         `json:",omitempty"` // Set only when recency weighting is enabled
+	Breakdown      *ScoreBreakdown `json:",omitempty"`
+	Trivial        []TrivialReason `json:",omitempty"` // Signals that flagged a trivial PR
 }
 
 // MapSemanticToOutputEventType converts semantic internal types to output-safe types.
@@ -68,6 +87,12 @@ func MapSemanticToOutputEventType(st SemanticEventType) FinalizedContributionTyp
 		return ContributionDiscussionComment
 	case SemanticEventCommit:
 		return ContributionCommit
+	case SemanticEventSecurityAdvisory:
+		return ContributionSecurityAdvisory
+	case SemanticEventReleasePublished:
+		return ContributionRelease
+	case SemanticEventTriage:
+		return ContributionTriage
 	default:
 		return ContributionUnknown
 	}
@@ -84,17 +109,52 @@ func MapEventsToContributions(events []SemanticEvent) []Contribution {
 			URL:            e.URL,
 			CreatedAt:      e.CreatedAt,
 			ReactionsCount: e.ReactionsCount,
+			Reactions:      e.Reactions,
 			Merged:         e.Merged,
+			MergedAt:       e.MergedAt,
+			ResolvedIssues: e.ResolvedIssues,
+			Advisory:       e.Advisory,
+			TriageAction:   e.TriageAction,
+			RecencyFactor:  e.RecencyFactor,
+			Breakdown:      e.Breakdown,
+			Trivial:        e.Trivial,
 		}
 	}
 	return contribs
 }
 
+// ScoreTotals sums a footprint's impact. Recent weights contributions by
+// recency and equals Lifetime when recency weighting is off. Owned projects
+// and private contributions are undated and count the same in both.
+type ScoreTotals struct {
+	Recent   float64
+	Lifetime float64
+}
+
+func TotalScores(repos []RepoContribution, projects []OwnedProjectImpact, private *PrivateContributions) ScoreTotals {
+	var totals ScoreTotals
+	for _, r := range repos {
+		totals.Recent += r.Score
+		totals.Lifetime += r.LifetimeScore
+	}
+	for _, p := range projects {
+		totals.Recent += p.Score
+		totals.Lifetime += p.Score
+	}
+	if private != nil {
+		totals.Recent += private.Score
+		totals.Lifetime += private.Score
+	}
+	return totals
+}
+
 // OwnedProjectImpact represents a finalized, weighted output for an owned repository.
 type OwnedProjectImpact struct {
 	Repo          string
 	URL           string
 	AvatarURL     string
+	Language      string // Primary language, empty when unknown
+	LanguageColor string
 	Stars         int
 	Forks         int
 	BaseScore     float64
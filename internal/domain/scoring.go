@@ -0,0 +1,390 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ContributionTypes lists every contribution type the fetchers produce.
+var ContributionTypes = []ContributionType{
+	ContributionTypePR,
+	ContributionTypePRComment,
+	ContributionTypeIssue,
+	ContributionTypeIssueComment,
+	ContributionTypeReview,
+	ContributionTypeReviewComment,
+	ContributionTypeDiscussion,
+	ContributionTypeDiscussionComment,
+	ContributionTypeSecurityAdvisory,
+	ContributionTypeRelease,
+	ContributionTypeTriage,
+}
+
+// AdvisorySeverities lists advisory severities from most to least severe.
+var AdvisorySeverities = []AdvisorySeverity{
+	AdvisorySeverityCritical,
+	AdvisorySeverityHigh,
+	AdvisorySeverityMedium,
+	AdvisorySeverityLow,
+}
+
+// ScoringConfig holds every weight that affects scores. Reports embed the
+// effective config and its Hash so a score can be reproduced.
+type ScoringConfig struct {
+	BaseScores     map[ContributionType]float64 `json:"baseScores"`
+	AdvisoryScores map[AdvisorySeverity]float64 `json:"advisoryScores"` // Replace the base score for advisory credits
+
+	MergedPRBonus     float64 `json:"mergedPRBonus"`  // Multiplies the base score of merged PRs
+	OwnershipScore    float64 `json:"ownershipScore"` // Base score of each owned project
+	RepoMultiplierCap float64 `json:"repoMultiplierCap"`
+
+	PopularityModel PopularityModel             `json:"popularityModel,omitempty"` // Empty means PopularityLog
+	PopularityCaps  map[PopularityModel]float64 `json:"popularityCaps,omitempty"`  // Per-model caps, overriding RepoMultiplierCap
+
+	// Repeated contributions of a decaying type in a repo lose value under
+	// DecayStrategy, at TriageDecayRate for triage and CommentDecayRate for
+	// every other type.
+	CommentDecayRate float64            `json:"commentDecayRate"`
+	TriageDecayRate  float64            `json:"triageDecayRate"`
+	DecayStrategy    DecayStrategy      `json:"decayStrategy,omitempty"`    // Empty means DecayHyperbolic
+	DecayTypes       []ContributionType `json:"decayTypes,omitempty"`       // Empty means DefaultDecayTypes
+	DecayAcrossTypes bool               `json:"decayAcrossTypes,omitempty"` // Count every decaying type in a repo together
+	DecayBudget      float64            `json:"decayBudget,omitempty"`      // Base score each repo can earn under DecayBudgeted
+	DecayWindow      DecayWindow        `json:"decayWindow,omitempty"`      // Period counts reset after under DecayWindowed; empty means month
+
+	ReleasePopularityDamping float64 `json:"releasePopularityDamping"`
+	PrivateCommitScore       float64 `json:"privateCommitScore"`
+
+	ResolvesIssuesBonus      bool    `json:"resolvesIssuesBonus"`
+	ResolvedIssueScore       float64 `json:"resolvedIssueScore"`
+	ResolvedIssueMaxAgeYears float64 `json:"resolvedIssueMaxAgeYears"`
+
+	ReactionWeights  map[ReactionContent]float64 `json:"reactionWeights,omitempty"` // Empty: reactions are not scored
+	ReactionBonusCap float64                     `json:"reactionBonusCap"`
+
+	// RecencyHalfLifeDays halves an event's score for every half-life of age
+	// at generation time. Zero disables recency weighting.
+	RecencyHalfLifeDays float64 `json:"recencyHalfLifeDays"`
+
+	Rules []ScoringRule `json:"rules,omitempty"` // Applied in order after bonuses, before decay
+
+	TrivialPR TrivialPRConfig `json:"trivialPR"`
+}
+
+// TrivialPRConfig flags low-effort PRs, such as typo fixes and README tweaks,
+// whose base score is then scaled by Multiplier. A PR is trivial when any
+// signal fires: every changed file is documentation, it changes at most
+// MaxLines lines, or its title matches one of TitlePatterns.
+type TrivialPRConfig struct {
+	Enabled    bool    `json:"enabled"`
+	Multiplier float64 `json:"multiplier"`
+
+	// DocsPaths are globs (path.Match) matched against each changed file's
+	// base name; a glob ending in "/" matches any directory in its path.
+	DocsPaths     []string `json:"docsPaths"`
+	MaxLines      int      `json:"maxLines"`      // Zero disables the size signal
+	TitlePatterns []string `json:"titlePatterns"` // Case-insensitive regular expressions
+}
+
+// TrivialReason is a signal that flagged a PR as trivial.
+type TrivialReason string
+
+const (
+	TrivialDocsOnly TrivialReason = "docs-only"
+	TrivialSmall    TrivialReason = "small"
+	TrivialTitle    TrivialReason = "title"
+)
+
+// TrivialReasons lists every trivial PR signal.
+var TrivialReasons = []TrivialReason{TrivialDocsOnly, TrivialSmall, TrivialTitle}
+
+// PopularityModel turns a repo's size into its raw popularity multiplier.
+type PopularityModel string
+
+const (
+	// PopularityLog is 1 + log10(1 + stars + 2 × forks).
+	PopularityLog PopularityModel = "log"
+	// PopularityPercentile ranks each repo's stars + 2 × forks among the
+	// other repos being scored: 1 for the smallest up to the cap.
+	PopularityPercentile PopularityModel = "percentile"
+	// PopularityDependents is 1 + log10(1 + dependents + downloads / 1000)
+	// where that data is known, falling back to PopularityLog.
+	PopularityDependents PopularityModel = "dependents"
+	// PopularityFlat scores every repo the same.
+	PopularityFlat PopularityModel = "flat"
+)
+
+// PopularityModels lists every popularity model.
+var PopularityModels = []PopularityModel{
+	PopularityLog,
+	PopularityPercentile,
+	PopularityDependents,
+	PopularityFlat,
+}
+
+// Model is the configured popularity model, defaulting to PopularityLog.
+func (c ScoringConfig) Model() PopularityModel {
+	if c.PopularityModel == "" {
+		return PopularityLog
+	}
+	return c.PopularityModel
+}
+
+// MultiplierCap bounds the popularity multiplier under the configured model.
+func (c ScoringConfig) MultiplierCap() float64 {
+	if limit, ok := c.PopularityCaps[c.Model()]; ok {
+		return limit
+	}
+	return c.RepoMultiplierCap
+}
+
+// DecayStrategy is how repeated contributions lose value. Count is the
+// number of earlier events in the same decay group: the same repo and, unless
+// DecayAcrossTypes is set, the same type.
+type DecayStrategy string
+
+const (
+	// DecayHyperbolic is 1 / (1 + rate × count): 1, 0.67, 0.5, 0.4...
+	DecayHyperbolic DecayStrategy = "hyperbolic"
+	// DecayExponential is e^(-rate × count): 1, 0.61, 0.37, 0.22...
+	DecayExponential DecayStrategy = "exponential"
+	// DecayBudgeted scores events in full until their base scores use up
+	// DecayBudget per group, and nothing after.
+	DecayBudgeted DecayStrategy = "budget"
+	// DecayWindowed is DecayHyperbolic with counts reset every DecayWindow.
+	DecayWindowed DecayStrategy = "window"
+)
+
+// DecayStrategies lists every decay strategy.
+var DecayStrategies = []DecayStrategy{DecayHyperbolic, DecayExponential, DecayBudgeted, DecayWindowed}
+
+// DecayWindow is a calendar period, in UTC, that DecayWindowed resets after.
+type DecayWindow string
+
+const (
+	DecayWeek    DecayWindow = "week"
+	DecayMonth   DecayWindow = "month"
+	DecayQuarter DecayWindow = "quarter"
+	DecayYear    DecayWindow = "year"
+)
+
+// DecayWindows lists every decay window.
+var DecayWindows = []DecayWindow{DecayWeek, DecayMonth, DecayQuarter, DecayYear}
+
+// DefaultDecayTypes are the types that decay by default: those where volume
+// says little about effort.
+var DefaultDecayTypes = []ContributionType{
+	ContributionTypeIssueComment,
+	ContributionTypeReviewComment,
+	ContributionTypePRComment,
+	ContributionTypeDiscussionComment,
+	ContributionTypeRelease,
+	ContributionTypeTriage,
+}
+
+// Strategy is the configured decay strategy, defaulting to DecayHyperbolic.
+func (c ScoringConfig) Strategy() DecayStrategy {
+	if c.DecayStrategy == "" {
+		return DecayHyperbolic
+	}
+	return c.DecayStrategy
+}
+
+// Window is the configured decay window, defaulting to DecayMonth.
+func (c ScoringConfig) Window() DecayWindow {
+	if c.DecayWindow == "" {
+		return DecayMonth
+	}
+	return c.DecayWindow
+}
+
+// Decays reports whether contributions of type t decay.
+func (c ScoringConfig) Decays(t ContributionType) bool {
+	if len(c.DecayTypes) == 0 {
+		return slices.Contains(DefaultDecayTypes, t)
+	}
+	return slices.Contains(c.DecayTypes, t)
+}
+
+// ScoringRule adjusts the score of every event its When expression matches,
+// e.g. {"when": "type == \"PR\" && \"security\" in labels", "multiply": 2}.
+// The expression language is described in package expr. Multiply applies
+// before Add; a zero Multiply leaves the score unscaled.
+type ScoringRule struct {
+	Name     string  `json:"name,omitempty"`
+	When     string  `json:"when"`
+	Multiply float64 `json:"multiply,omitempty"`
+	Add      float64 `json:"add,omitempty"`
+}
+
+// Label names the rule in breakdowns and errors.
+func (r ScoringRule) Label() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.When
+}
+
+// Validate reports every weight that is out of range or unknown.
+func (c ScoringConfig) Validate() error {
+	var errs []error
+	nonNegative := func(name string, v float64) {
+		if v < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative, got %g", name, v))
+		}
+	}
+
+	for _, t := range slices.Sorted(maps.Keys(c.BaseScores)) {
+		if !slices.Contains(ContributionTypes, t) {
+			errs = append(errs, fmt.Errorf("baseScores: unknown contribution type %q", t))
+		}
+		nonNegative(fmt.Sprintf("baseScores.%s", t), c.BaseScores[t])
+	}
+	for _, t := range ContributionTypes {
+		if _, ok := c.BaseScores[t]; !ok && t != ContributionTypeSecurityAdvisory && t != ContributionTypePRComment {
+			errs = append(errs, fmt.Errorf("baseScores: missing score for %s", t))
+		}
+	}
+
+	for _, s := range slices.Sorted(maps.Keys(c.AdvisoryScores)) {
+		if !slices.Contains(AdvisorySeverities, s) {
+			errs = append(errs, fmt.Errorf("advisoryScores: unknown severity %q", s))
+		}
+		nonNegative(fmt.Sprintf("advisoryScores.%s", s), c.AdvisoryScores[s])
+	}
+	if _, ok := c.AdvisoryScores[AdvisorySeverityLow]; !ok {
+		errs = append(errs, fmt.Errorf("advisoryScores: missing score for %s, used for unknown severities", AdvisorySeverityLow))
+	}
+
+	// Negative reaction weights are allowed, e.g. for THUMBS_DOWN
+	for _, r := range slices.Sorted(maps.Keys(c.ReactionWeights)) {
+		if !slices.Contains(ReactionContents, r) {
+			errs = append(errs, fmt.Errorf("reactionWeights: unknown reaction %q", r))
+		}
+	}
+
+	nonNegative("mergedPRBonus", c.MergedPRBonus)
+	nonNegative("ownershipScore", c.OwnershipScore)
+	nonNegative("commentDecayRate", c.CommentDecayRate)
+	nonNegative("triageDecayRate", c.TriageDecayRate)
+	nonNegative("privateCommitScore", c.PrivateCommitScore)
+	nonNegative("resolvedIssueScore", c.ResolvedIssueScore)
+	nonNegative("reactionBonusCap", c.ReactionBonusCap)
+	nonNegative("recencyHalfLifeDays", c.RecencyHalfLifeDays)
+	for i, r := range c.Rules {
+		if strings.TrimSpace(r.When) == "" {
+			errs = append(errs, fmt.Errorf("rules[%d]: missing when", i))
+		}
+		if r.Multiply < 0 {
+			errs = append(errs, fmt.Errorf("rules[%d]: multiply must not be negative, got %g", i, r.Multiply))
+		}
+		if r.Multiply == 0 && r.Add == 0 {
+			errs = append(errs, fmt.Errorf("rules[%d]: needs multiply or add", i))
+		}
+	}
+	if !slices.Contains(PopularityModels, c.Model()) {
+		errs = append(errs, fmt.Errorf("popularityModel: unknown model %q", c.PopularityModel))
+	}
+	for _, m := range slices.Sorted(maps.Keys(c.PopularityCaps)) {
+		if !slices.Contains(PopularityModels, m) {
+			errs = append(errs, fmt.Errorf("popularityCaps: unknown model %q", m))
+		}
+		if c.PopularityCaps[m] < 1 {
+			errs = append(errs, fmt.Errorf("popularityCaps.%s must be at least 1, got %g", m, c.PopularityCaps[m]))
+		}
+	}
+	if !slices.Contains(DecayStrategies, c.Strategy()) {
+		errs = append(errs, fmt.Errorf("decayStrategy: unknown strategy %q", c.DecayStrategy))
+	}
+	if !slices.Contains(DecayWindows, c.Window()) {
+		errs = append(errs, fmt.Errorf("decayWindow: unknown window %q", c.DecayWindow))
+	}
+	for _, t := range c.DecayTypes {
+		if !slices.Contains(ContributionTypes, t) {
+			errs = append(errs, fmt.Errorf("decayTypes: unknown contribution type %q", t))
+		}
+	}
+	nonNegative("decayBudget", c.DecayBudget)
+	if c.Strategy() == DecayBudgeted && c.DecayBudget == 0 {
+		errs = append(errs, fmt.Errorf("decayBudget must be positive with the budget strategy"))
+	}
+	if c.TrivialPR.Multiplier < 0 || c.TrivialPR.Multiplier > 1 {
+		errs = append(errs, fmt.Errorf("trivialPR.multiplier must be between 0 and 1, got %g", c.TrivialPR.Multiplier))
+	}
+	if c.TrivialPR.MaxLines < 0 {
+		errs = append(errs, fmt.Errorf("trivialPR.maxLines must not be negative, got %d", c.TrivialPR.MaxLines))
+	}
+	for i, glob := range c.TrivialPR.DocsPaths {
+		if _, err := path.Match(strings.TrimSuffix(glob, "/"), ""); err != nil {
+			errs = append(errs, fmt.Errorf("trivialPR.docsPaths[%d]: invalid glob %q", i, glob))
+		}
+	}
+	for i, pattern := range c.TrivialPR.TitlePatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errs = append(errs, fmt.Errorf("trivialPR.titlePatterns[%d]: %w", i, err))
+		}
+	}
+	if c.RepoMultiplierCap < 1 {
+		errs = append(errs, fmt.Errorf("repoMultiplierCap must be at least 1, got %g", c.RepoMultiplierCap))
+	}
+	if c.ReleasePopularityDamping < 0 || c.ReleasePopularityDamping > 1 {
+		errs = append(errs, fmt.Errorf("releasePopularityDamping must be between 0 and 1, got %g", c.ReleasePopularityDamping))
+	}
+	if c.ResolvedIssueMaxAgeYears <= 0 {
+		errs = append(errs, fmt.Errorf("resolvedIssueMaxAgeYears must be positive, got %g", c.ResolvedIssueMaxAgeYears))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Hash identifies the config: equal configs always hash the same, since
+// encoding/json sorts map keys.
+func (c ScoringConfig) Hash() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// ScoreBreakdown explains how a single event reached its share of a repo's
+// score:
+//
+//	(Base × MergedBonus + ResolvedIssues + Reactions) × DecayFactor × Recency × Multiplier = Score
+//
+// with each of Rules applied to the bracketed sum in order. Scoring fills in
+// everything up to Recency; aggregation fills in the rest once the repo's
+// peak popularity is known.
+type ScoreBreakdown struct {
+	Base           float64         `json:"base"`
+	Trivial        []TrivialReason `json:"trivial,omitempty"`       // Signals that flagged a trivial PR
+	TrivialFactor  float64         `json:"trivialFactor,omitempty"` // Multiplies Base when Trivial is set
+	MergedBonus    float64         `json:"mergedBonus"`             // 1 unless the event is a merged PR
+	ResolvedIssues float64         `json:"resolvedIssues,omitempty"`
+	Reactions      float64         `json:"reactions,omitempty"`
+	Rules          []AppliedRule   `json:"rules,omitempty"`
+	DecayIndex     int             `json:"decayIndex,omitempty"` // Earlier events in the same decay group
+	DecayFactor    float64         `json:"decayFactor"`
+	Recency        float64         `json:"recency"` // 1 unless recency weighting is enabled
+
+	Popularity float64 `json:"popularity"` // The event's own raw popularity
+	Multiplier float64 `json:"multiplier"` // Popularity multiplier applied: the repo's peak, or the release's own
+	Capped     bool    `json:"capped,omitempty"`
+	Score      float64 `json:"score"` // Contribution to the repo score
+}
+
+// AppliedRule is a ScoringRule that matched an event.
+type AppliedRule struct {
+	Rule     string  `json:"rule"` // ScoringRule.Label
+	Multiply float64 `json:"multiply,omitempty"`
+	Add      float64 `json:"add,omitempty"`
+}
@@ -15,20 +15,35 @@ const (
 	SemanticEventDiscussionOpened  SemanticEventType = "DISCUSSION_OPENED"
 	SemanticEventDiscussionComment SemanticEventType = "DISCUSSION_COMMENT"
 	SemanticEventCommit            SemanticEventType = "COMMIT"
+	SemanticEventSecurityAdvisory  SemanticEventType = "SECURITY_ADVISORY"
+	SemanticEventReleasePublished  SemanticEventType = "RELEASE_PUBLISHED"
+	SemanticEventTriage            SemanticEventType = "TRIAGE"
 )
 
 type SemanticEvent struct {
-	ID             string            `json:"id"`
-	Type           SemanticEventType `json:"type"`
-	Repo           string            `json:"repo"`
-	AvatarURL      string            `json:"avatar_url"`
-	URL            string            `json:"url"`
-	Title          string            `json:"title,omitempty"`
-	CreatedAt      time.Time         `json:"created_at"`
-	BaseScore      float64           `json:"base_score"`
-	PopularityRaw  float64           `json:"popularity_raw"`
-	Merged         bool              `json:"merged"`
-	ReactionsCount int               `json:"reactions_count"`
+	ID             string                  `json:"id"`
+	Type           SemanticEventType       `json:"type"`
+	Repo           string                  `json:"repo"`
+	AvatarURL      string                  `json:"avatar_url"`
+	Language       string                  `json:"language,omitempty"`
+	LanguageColor  string                  `json:"language_color,omitempty"`
+	URL            string                  `json:"url"`
+	Title          string                  `json:"title,omitempty"`
+	CreatedAt      time.Time               `json:"created_at"`
+	BaseScore      float64                 `json:"base_score"`
+	PopularityRaw  float64                 `json:"popularity_raw"`
+	RecencyFactor  float64                 `json:"recency_factor,omitempty"`
+	Breakdown      *ScoreBreakdown         `json:"breakdown,omitempty"`
+	Trivial        []TrivialReason         `json:"trivial,omitempty"`
+	Merged         bool                    `json:"merged"`
+	MergedAt       time.Time               `json:"merged_at,omitzero"`
+	ReactionsCount int                     `json:"reactions_count"`
+	Reactions      map[ReactionContent]int `json:"reactions,omitempty"`
+	ResolvedIssues []LinkedIssue           `json:"resolved_issues,omitempty"`
+	Advisory       *AdvisoryCredit         `json:"advisory,omitempty"`
+	TriageAction   TriageAction            `json:"triage_action,omitempty"`
+	Stars          int                     `json:"stars"`
+	FirstTimer     bool                    `json:"first_timer,omitempty"` // Author was a first-time contributor when the item was opened
 }
 
 // StatsView represents raw activity counts (unweighted).
@@ -41,7 +56,28 @@ type StatsView struct {
 	DiscussionsOpened       int
 	DiscussionComments      int
 	TotalCommits            int
+	SecurityAdvisories      int
+	ReleasesPublished       int
+	TriageActions           int
 	ProjectsOwned           int
 	StarsEarned             int
 	TotalReposContributedTo int
+
+	// Private is set only when private contribution stats are opted in.
+	Private *PrivateContributions `json:",omitempty"`
+}
+
+// PrivateContributions holds aggregate-only counts for private repositories.
+// It never carries repository names or titles.
+type PrivateContributions struct {
+	Restricted   int // Contributions hidden from the token (restrictedContributionsCount)
+	Commits      int
+	Issues       int
+	PullRequests int
+	Reviews      int
+	Score        float64 // Zero unless private contributions are explicitly scored
+}
+
+func (p PrivateContributions) Total() int {
+	return p.Restricted + p.Commits + p.Issues + p.PullRequests + p.Reviews
 }
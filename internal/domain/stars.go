@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// StarSample records that a repo had Stars stars at At.
+type StarSample struct {
+	At    time.Time `json:"at"`
+	Stars int       `json:"stars"`
+}
+
+// StarHistory is a sampled star count curve of a repo, oldest sample first.
+type StarHistory struct {
+	FetchedAt time.Time    `json:"fetched_at"`
+	Samples   []StarSample `json:"samples"`
+}
+
+// StarsAt estimates the star count at t by interpolating linearly between
+// the samples around it. Before the first sample a repo has no stars; after
+// the last it keeps the last count.
+func (h StarHistory) StarsAt(t time.Time) int {
+	samples := h.Samples
+	if len(samples) == 0 || t.Before(samples[0].At) {
+		return 0
+	}
+	i := sort.Search(len(samples), func(i int) bool { return samples[i].At.After(t) })
+	if i == len(samples) {
+		return samples[len(samples)-1].Stars
+	}
+	prev, next := samples[i-1], samples[i]
+	span := next.At.Sub(prev.At)
+	if span <= 0 {
+		return prev.Stars
+	}
+	frac := float64(t.Sub(prev.At)) / float64(span)
+	return prev.Stars + int(frac*float64(next.Stars-prev.Stars))
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStarHistory_StarsAt(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	h := StarHistory{Samples: []StarSample{
+		{At: day(1), Stars: 1},
+		{At: day(11), Stars: 101},
+		{At: day(21), Stars: 1001},
+	}}
+
+	cases := []struct {
+		at   time.Time
+		want int
+	}{
+		{day(1).Add(-time.Hour), 0},
+		{day(1), 1},
+		{day(6), 51},
+		{day(11), 101},
+		{day(16), 551},
+		{day(30), 1001},
+	}
+	for _, tc := range cases {
+		if got := h.StarsAt(tc.at); got != tc.want {
+			t.Errorf("StarsAt(%s): expected %d, got %d", tc.at.Format(time.DateOnly), tc.want, got)
+		}
+	}
+	if got := (StarHistory{}).StarsAt(day(1)); got != 0 {
+		t.Errorf("expected an empty history to estimate 0, got %d", got)
+	}
+}
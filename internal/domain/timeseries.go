@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+)
+
+// Granularity is the length of a TimeBucket.
+type Granularity string
+
+const (
+	GranularityDay   Granularity = "day"
+	GranularityWeek  Granularity = "week" // ISO weeks, starting on Monday
+	GranularityMonth Granularity = "month"
+)
+
+// Activity is how many contributions happened and the score they earned.
+type Activity struct {
+	Count int     `json:"count"`
+	Score float64 `json:"score"`
+}
+
+func (a *Activity) Add(score float64) {
+	a.Count++
+	a.Score += score
+}
+
+// TimeBucket is the activity in one day, week or month, in total and split by
+// semantic type and by repo.
+type TimeBucket struct {
+	Start time.Time `json:"start"` // Midnight UTC on the first day of the period
+	Activity
+	Types map[SemanticEventType]Activity `json:"types"`
+	Repos map[string]Activity            `json:"repos"`
+}
+
+// TimeSeries buckets a footprint's activity by period, oldest first. Periods
+// without activity are left out.
+type TimeSeries struct {
+	Day   []TimeBucket `json:"day"`
+	Week  []TimeBucket `json:"week"`
+	Month []TimeBucket `json:"month"`
+}
+
+// Buckets returns the series for g.
+func (s TimeSeries) Buckets(g Granularity) []TimeBucket {
+	switch g {
+	case GranularityDay:
+		return s.Day
+	case GranularityWeek:
+		return s.Week
+	case GranularityMonth:
+		return s.Month
+	default:
+		return nil
+	}
+}
+
+// BucketStart truncates t to the start of its period in UTC.
+func BucketStart(t time.Time, g Granularity) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch g {
+	case GranularityWeek:
+		// Monday is day 0 of an ISO week
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case GranularityMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
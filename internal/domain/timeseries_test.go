@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBucketStart(t *testing.T) {
+	// A Sunday evening west of UTC is already Monday in UTC
+	at := time.Date(2026, 3, 15, 22, 30, 0, 0, time.FixedZone("EST", -5*60*60))
+
+	tests := []struct {
+		g    Granularity
+		want time.Time
+	}{
+		{GranularityDay, time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{GranularityWeek, time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{GranularityMonth, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := BucketStart(at, tt.g); !got.Equal(tt.want) {
+			t.Errorf("BucketStart(%s) = %v, want %v", tt.g, got, tt.want)
+		}
+	}
+
+	sunday := time.Date(2026, 3, 22, 12, 0, 0, 0, time.UTC)
+	if got := BucketStart(sunday, GranularityWeek); !got.Equal(time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected Sunday to belong to the week starting Monday the 16th, got %v", got)
+	}
+}
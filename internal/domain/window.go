@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeWindow bounds the contributions a footprint covers. Since is inclusive
+// and Until is exclusive; a zero bound leaves that side open.
+type TimeWindow struct {
+	Since time.Time `json:"since,omitzero"`
+	Until time.Time `json:"until,omitzero"`
+}
+
+func (w TimeWindow) IsZero() bool {
+	return w.Since.IsZero() && w.Until.IsZero()
+}
+
+// Contains reports whether t falls inside the window.
+func (w TimeWindow) Contains(t time.Time) bool {
+	if !w.Since.IsZero() && t.Before(w.Since) {
+		return false
+	}
+	if !w.Until.IsZero() && !t.Before(w.Until) {
+		return false
+	}
+	return true
+}
+
+// Ended reports whether t is before the window starts. Walks over newest-first
+// listings stop at the first such item.
+func (w TimeWindow) Ended(t time.Time) bool {
+	return !w.Since.IsZero() && t.Before(w.Since)
+}
+
+// String describes the window for display: "All-time", "2025",
+// "2025-01-01 – 2025-06-30", "Since 2025-01-01" or "Until 2025-06-30".
+// Until is shown as the last day it includes.
+func (w TimeWindow) String() string {
+	const day = "2006-01-02"
+	switch {
+	case w.IsZero():
+		return "All-time"
+	case w.Until.IsZero():
+		return "Since " + w.Since.Format(day)
+	case w.Since.IsZero():
+		return "Until " + w.lastDay().Format(day)
+	case w.Since.YearDay() == 1 && w.Since.Equal(startOfDay(w.Since)) && w.Until.Equal(w.Since.AddDate(1, 0, 0)):
+		return w.Since.Format("2006")
+	default:
+		return w.Since.Format(day) + " – " + w.lastDay().Format(day)
+	}
+}
+
+func (w TimeWindow) lastDay() time.Time {
+	return w.Until.Add(-time.Nanosecond)
+}
+
+// ParseTimeWindow parses -since and -until values. Each may be empty (open),
+// a date ("2025", "2025-06" or "2025-06-30"), an RFC 3339 timestamp, or a
+// period before now ("90d", "6w", "12m", "1y"). Dates cover the whole period
+// they name, so "-since=2025 -until=2025" is the calendar year 2025.
+func ParseTimeWindow(since, until string, now time.Time) (TimeWindow, error) {
+	var w TimeWindow
+	var err error
+	if since != "" {
+		if w.Since, _, err = parseWindowBound(since, now); err != nil {
+			return TimeWindow{}, fmt.Errorf("invalid since %q: %w", since, err)
+		}
+	}
+	if until != "" {
+		if _, w.Until, err = parseWindowBound(until, now); err != nil {
+			return TimeWindow{}, fmt.Errorf("invalid until %q: %w", until, err)
+		}
+	}
+	if !w.Since.IsZero() && !w.Until.IsZero() && !w.Since.Before(w.Until) {
+		return TimeWindow{}, fmt.Errorf("since %s is not before until %s", since, until)
+	}
+	return w, nil
+}
+
+// parseWindowBound returns the start and (exclusive) end of the period a
+// bound names. Timestamps and relative periods name a single instant.
+func parseWindowBound(value string, now time.Time) (start, end time.Time, err error) {
+	if n, unit := value[:len(value)-1], value[len(value)-1]; strings.ContainsRune("dwmy", rune(unit)) {
+		count, err := strconv.Atoi(n)
+		if err != nil || count < 0 {
+			return time.Time{}, time.Time{}, fmt.Errorf("expected a count before %q", string(unit))
+		}
+		switch unit {
+		case 'd':
+			start = now.AddDate(0, 0, -count)
+		case 'w':
+			start = now.AddDate(0, 0, -7*count)
+		case 'm':
+			start = now.AddDate(0, -count, 0)
+		case 'y':
+			start = now.AddDate(-count, 0, 0)
+		}
+		return start, start, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, t, nil
+	}
+	for _, p := range []struct {
+		layout string
+		years  int
+		months int
+		days   int
+	}{
+		{"2006", 1, 0, 0},
+		{"2006-01", 0, 1, 0},
+		{"2006-01-02", 0, 0, 1},
+	} {
+		if t, err := time.Parse(p.layout, value); err == nil {
+			return t, t.AddDate(p.years, p.months, p.days), nil
+		}
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("expected YYYY, YYYY-MM, YYYY-MM-DD, an RFC 3339 time or a period like 90d")
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
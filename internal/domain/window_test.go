@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimeWindow(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		since, until string
+		want         TimeWindow
+		label        string
+	}{
+		{"", "", TimeWindow{}, "All-time"},
+		{"2024", "2024", TimeWindow{Since: date(2024, 1, 1), Until: date(2025, 1, 1)}, "2024"},
+		{"2024-03", "2024-04", TimeWindow{Since: date(2024, 3, 1), Until: date(2024, 5, 1)}, "2024-03-01 – 2024-04-30"},
+		{"2024-03-10", "", TimeWindow{Since: date(2024, 3, 10)}, "Since 2024-03-10"},
+		{"", "2024-12-31", TimeWindow{Until: date(2025, 1, 1)}, "Until 2024-12-31"},
+		{"90d", "", TimeWindow{Since: now.AddDate(0, 0, -90)}, "Since 2025-03-17"},
+		{"12m", "", TimeWindow{Since: now.AddDate(0, -12, 0)}, "Since 2024-06-15"},
+	}
+	for _, tt := range tests {
+		got, err := ParseTimeWindow(tt.since, tt.until, now)
+		if err != nil {
+			t.Fatalf("since=%q until=%q: unexpected error %v", tt.since, tt.until, err)
+		}
+		if !got.Since.Equal(tt.want.Since) || !got.Until.Equal(tt.want.Until) {
+			t.Errorf("since=%q until=%q: expected %+v, got %+v", tt.since, tt.until, tt.want, got)
+		}
+		if got.String() != tt.label {
+			t.Errorf("since=%q until=%q: expected label %q, got %q", tt.since, tt.until, tt.label, got.String())
+		}
+	}
+
+	for _, bad := range [][2]string{{"last year", ""}, {"xd", ""}, {"2025", "2024"}} {
+		if _, err := ParseTimeWindow(bad[0], bad[1], now); err == nil {
+			t.Errorf("expected since=%q until=%q to be rejected", bad[0], bad[1])
+		}
+	}
+}
+
+func TestTimeWindowContains(t *testing.T) {
+	w := TimeWindow{
+		Since: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	if !w.Contains(w.Since) {
+		t.Error("expected since to be inclusive")
+	}
+	if w.Contains(w.Until) {
+		t.Error("expected until to be exclusive")
+	}
+	if !w.Ended(w.Since.Add(-time.Second)) || w.Ended(w.Until) {
+		t.Error("expected only times before since to end the window")
+	}
+	if !(TimeWindow{}).Contains(time.Time{}) {
+		t.Error("expected an open window to contain everything")
+	}
+}
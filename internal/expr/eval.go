@@ -0,0 +1,133 @@
+package expr
+
+import (
+	"path"
+	"slices"
+	"time"
+)
+
+// node is a type-checked expression.
+type node interface {
+	typ() Type
+	eval(vars Vars) any
+}
+
+type literal struct {
+	value any
+	t     Type
+}
+
+func (n *literal) typ() Type       { return n.t }
+func (n *literal) eval(_ Vars) any { return n.value }
+
+type variable struct {
+	name string
+	t    Type
+}
+
+func (n *variable) typ() Type { return n.t }
+
+func (n *variable) eval(vars Vars) any {
+	if v, ok := vars[n.name]; ok {
+		return v
+	}
+	return zero(n.t)
+}
+
+type logical struct {
+	op          string
+	left, right node
+}
+
+func (n *logical) typ() Type { return Bool }
+
+func (n *logical) eval(vars Vars) any {
+	left := n.left.eval(vars).(bool)
+	if n.op == "&&" {
+		return left && n.right.eval(vars).(bool)
+	}
+	return left || n.right.eval(vars).(bool)
+}
+
+type not struct {
+	operand node
+}
+
+func (n *not) typ() Type { return Bool }
+
+func (n *not) eval(vars Vars) any {
+	return !n.operand.eval(vars).(bool)
+}
+
+type compare struct {
+	op          string
+	left, right node
+}
+
+func (n *compare) typ() Type { return Bool }
+
+func (n *compare) eval(vars Vars) any {
+	left, right := n.left.eval(vars), n.right.eval(vars)
+	var c int
+	switch l := left.(type) {
+	case float64:
+		c = cmpFloat(l, right.(float64))
+	case time.Time:
+		c = l.Compare(right.(time.Time))
+	default:
+		// Strings and bools only support == and !=
+		if left == right {
+			c = 0
+		} else {
+			c = 1
+		}
+	}
+
+	switch n.op {
+	case "==":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	default:
+		return c >= 0
+	}
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+type in struct {
+	left, right node
+}
+
+func (n *in) typ() Type { return Bool }
+
+func (n *in) eval(vars Vars) any {
+	return slices.Contains(n.right.eval(vars).([]string), n.left.eval(vars).(string))
+}
+
+type matches struct {
+	left    node
+	pattern string
+}
+
+func (n *matches) typ() Type { return Bool }
+
+func (n *matches) eval(vars Vars) any {
+	ok, _ := path.Match(n.pattern, n.left.eval(vars).(string))
+	return ok
+}
@@ -0,0 +1,121 @@
+// Package expr is the expression language of custom scoring rules, e.g.
+//
+//	type == "PR" && "security" in labels
+//	type == "REVIEW" && stars > 5000 && date >= date("2024-01-01")
+//
+// Expressions are sandboxed: they can only read the variables declared in
+// their Env, have no loops, assignments or user-defined functions, and are
+// type-checked when compiled, so a compiled Program always evaluates.
+//
+// Operators, loosest binding first: ||, &&, !, then the comparisons ==, !=,
+// <, <=, >, >=, in (string in list) and matches (string against a glob, as
+// in path.Match). Literals are numbers, "strings", true, false, lists of
+// strings like ["bug", "security"] and dates written date("2006-01-02").
+package expr
+
+import (
+	"fmt"
+	"time"
+)
+
+// Type is the static type of a variable or expression.
+type Type int
+
+const (
+	Bool Type = iota + 1
+	Number
+	String
+	StringList
+	Date
+)
+
+func (t Type) String() string {
+	switch t {
+	case Bool:
+		return "bool"
+	case Number:
+		return "number"
+	case String:
+		return "string"
+	case StringList:
+		return "list"
+	case Date:
+		return "date"
+	default:
+		return "unknown"
+	}
+}
+
+// Env declares the variables an expression may use.
+type Env map[string]Type
+
+// Vars holds variable values: bool, float64, string, []string or time.Time
+// to match the declared Type. Missing variables read as their zero value.
+type Vars map[string]any
+
+// Error is a compile error at a column (1-based) of the source.
+type Error struct {
+	Col int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("col %d: %s", e.Col, e.Msg)
+}
+
+func errorf(col int, format string, args ...any) *Error {
+	return &Error{Col: col, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Program is a compiled boolean expression.
+type Program struct {
+	source string
+	root   node
+}
+
+// Compile parses and type-checks source against env. The expression must be
+// a bool.
+func Compile(source string, env Env) (*Program, error) {
+	p := &parser{lex: lexer{src: source}, env: env}
+	p.next()
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	switch p.tok.kind {
+	case tokEOF:
+	case tokErr:
+		return nil, errorf(p.tok.col, "%s", p.tok.text)
+	default:
+		return nil, errorf(p.tok.col, "unexpected %s", p.tok)
+	}
+	if root.typ() != Bool {
+		return nil, errorf(1, "expression must be a bool, got %s", root.typ())
+	}
+	return &Program{source: source, root: root}, nil
+}
+
+// Eval reports whether the expression holds for vars.
+func (p *Program) Eval(vars Vars) bool {
+	return p.root.eval(vars).(bool)
+}
+
+func (p *Program) String() string {
+	return p.source
+}
+
+// zero is the value a missing variable reads as.
+func zero(t Type) any {
+	switch t {
+	case Bool:
+		return false
+	case Number:
+		return 0.0
+	case String:
+		return ""
+	case StringList:
+		return []string(nil)
+	default:
+		return time.Time{}
+	}
+}
@@ -0,0 +1,100 @@
+package expr
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+var testEnv = Env{
+	"type":   String,
+	"repo":   String,
+	"stars":  Number,
+	"merged": Bool,
+	"labels": StringList,
+	"date":   Date,
+}
+
+func TestEval(t *testing.T) {
+	vars := Vars{
+		"type":   "PR",
+		"repo":   "kubernetes/kubernetes",
+		"stars":  120000.0,
+		"merged": true,
+		"labels": []string{"kind/bug", "security"},
+		"date":   time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		source string
+		want   bool
+	}{
+		{`type == "PR"`, true},
+		{`type != "PR"`, false},
+		{`type == "PR" && "security" in labels`, true},
+		{`"docs" in labels || stars > 5000`, true},
+		{`!merged`, false},
+		{`!(merged && stars >= 200000)`, true},
+		{`stars > 1_000 && stars <= 120000`, true},
+		{`stars < 120000`, false},
+		{`repo matches "kubernetes/*"`, true},
+		{`repo matches "golang/*"`, false},
+		{`type in ["PR", "ISSUE"]`, true},
+		{`date >= date("2024-01-01") && date < date("2025-01-01")`, true},
+		{`merged == true`, true},
+		{`true || false && false`, true},
+	}
+	for _, tt := range tests {
+		program, err := Compile(tt.source, testEnv)
+		if err != nil {
+			t.Errorf("Compile(%s): %v", tt.source, err)
+			continue
+		}
+		if got := program.Eval(vars); got != tt.want {
+			t.Errorf("Eval(%s) = %v, want %v", tt.source, got, tt.want)
+		}
+	}
+}
+
+func TestEval_MissingVariablesReadAsZero(t *testing.T) {
+	program, err := Compile(`stars == 0 && !("x" in labels) && repo == ""`, testEnv)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	if !program.Eval(Vars{}) {
+		t.Errorf("expected missing variables to read as zero values")
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{`stars > "5000"`, "col 7: cannot compare number with string"},
+		{`type > "PR"`, "col 6: > needs numbers or dates, got string"},
+		{`labels == ["a"]`, "col 8: cannot compare lists with ==; use in"},
+		{`"a" in type`, "col 5: in needs a string and a list, got string and string"},
+		{`star > 5`, `col 1: unknown variable "star"`},
+		{`stars`, "col 1: expression must be a bool, got number"},
+		{`merged && stars`, "col 8: && needs bools, got bool and number"},
+		{`!stars`, "col 1: ! needs a bool, got number"},
+		{`(merged`, `col 8: expected ")", got end of expression`},
+		{`merged merged`, `col 8: unexpected "merged"`},
+		{`type == "PR`, "col 9: unterminated string"},
+		{`date > date("June")`, `col 13: date needs YYYY-MM-DD, got "June"`},
+		{`repo matches "[a"`, `col 14: invalid pattern "[a"`},
+		{`stars > 5 # comment`, `col 11: unexpected character '#'`},
+		{`type in ["a", 1]`, "col 15: lists hold strings, got number 1"},
+	}
+	for _, tt := range tests {
+		_, err := Compile(tt.source, testEnv)
+		if err == nil {
+			t.Errorf("Compile(%s): expected an error", tt.source)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Compile(%s) = %q, want %q", tt.source, err, tt.want)
+		}
+	}
+}
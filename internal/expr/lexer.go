@@ -0,0 +1,111 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokOp
+	tokErr
+)
+
+type token struct {
+	kind tokenKind
+	text string // Operator or identifier text, or the unquoted string
+	num  float64
+	col  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of expression"
+	case tokString:
+		return strconv.Quote(t.text)
+	case tokNumber:
+		return fmt.Sprintf("number %s", strconv.FormatFloat(t.num, 'g', -1, 64))
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ","}
+
+func (l *lexer) next() token {
+	for l.pos < len(l.src) && strings.ContainsRune(" \t\r\n", rune(l.src[l.pos])) {
+		l.pos++
+	}
+	col := l.pos + 1
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, col: col}
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case c == '"':
+		end := l.pos + 1
+		for end < len(l.src) && l.src[end] != '"' {
+			if l.src[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(l.src) {
+			return token{kind: tokErr, text: "unterminated string", col: col}
+		}
+		s, err := strconv.Unquote(l.src[l.pos : end+1])
+		if err != nil {
+			return token{kind: tokErr, text: "invalid string " + l.src[l.pos:end+1], col: col}
+		}
+		l.pos = end + 1
+		return token{kind: tokString, text: s, col: col}
+	case isDigit(c):
+		end := l.pos
+		for end < len(l.src) && (isDigit(l.src[end]) || l.src[end] == '.' || l.src[end] == '_') {
+			end++
+		}
+		n, err := strconv.ParseFloat(l.src[l.pos:end], 64)
+		if err != nil {
+			return token{kind: tokErr, text: "invalid number " + l.src[l.pos:end], col: col}
+		}
+		l.pos = end
+		return token{kind: tokNumber, num: n, col: col}
+	case isLetter(c):
+		end := l.pos
+		for end < len(l.src) && (isLetter(l.src[end]) || isDigit(l.src[end])) {
+			end++
+		}
+		word := l.src[l.pos:end]
+		l.pos = end
+		return token{kind: tokIdent, text: word, col: col}
+	}
+
+	for _, op := range operators {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return token{kind: tokOp, text: op, col: col}
+		}
+	}
+	return token{kind: tokErr, text: fmt.Sprintf("unexpected character %q", c), col: col}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
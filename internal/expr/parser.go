@@ -0,0 +1,249 @@
+package expr
+
+import (
+	"path"
+	"slices"
+	"time"
+)
+
+type parser struct {
+	lex lexer
+	env Env
+	tok token
+}
+
+func (p *parser) next() {
+	p.tok = p.lex.next()
+}
+
+func (p *parser) isOp(op string) bool {
+	return p.tok.kind == tokOp && p.tok.text == op
+}
+
+func (p *parser) isKeyword(word string) bool {
+	return p.tok.kind == tokIdent && p.tok.text == word
+}
+
+func (p *parser) expect(op string) error {
+	if p.tok.kind == tokErr {
+		return errorf(p.tok.col, "%s", p.tok.text)
+	}
+	if !p.isOp(op) {
+		return errorf(p.tok.col, "expected %q, got %s", op, p.tok)
+	}
+	p.next()
+	return nil
+}
+
+// parseOr parses: and ("||" and)*
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("||") {
+		col := p.tok.col
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkBools(col, "||", left, right); err != nil {
+			return nil, err
+		}
+		left = &logical{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses: unary ("&&" unary)*
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("&&") {
+		col := p.tok.col
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkBools(col, "&&", left, right); err != nil {
+			return nil, err
+		}
+		left = &logical{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseUnary parses: "!" unary | comparison
+func (p *parser) parseUnary() (node, error) {
+	if p.isOp("!") {
+		col := p.tok.col
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if operand.typ() != Bool {
+			return nil, errorf(col, "! needs a bool, got %s", operand.typ())
+		}
+		return &not{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+var comparisons = []string{"==", "!=", "<", "<=", ">", ">="}
+
+// parseComparison parses: primary (op primary)?
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	col := p.tok.col
+	switch {
+	case p.tok.kind == tokOp && slices.Contains(comparisons, p.tok.text):
+		op := p.tok.text
+		p.next()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		if left.typ() != right.typ() {
+			return nil, errorf(col, "cannot compare %s with %s", left.typ(), right.typ())
+		}
+		ordered := op != "==" && op != "!="
+		switch {
+		case left.typ() == StringList:
+			return nil, errorf(col, "cannot compare lists with %s; use in", op)
+		case ordered && left.typ() != Number && left.typ() != Date:
+			return nil, errorf(col, "%s needs numbers or dates, got %s", op, left.typ())
+		}
+		return &compare{op: op, left: left, right: right}, nil
+
+	case p.isKeyword("in"):
+		p.next()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		if left.typ() != String || right.typ() != StringList {
+			return nil, errorf(col, "in needs a string and a list, got %s and %s", left.typ(), right.typ())
+		}
+		return &in{left: left, right: right}, nil
+
+	case p.isKeyword("matches"):
+		p.next()
+		patternCol := p.tok.col
+		if p.tok.kind != tokString {
+			return nil, errorf(patternCol, "matches needs a quoted pattern, got %s", p.tok)
+		}
+		pattern := p.tok.text
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, errorf(patternCol, "invalid pattern %q", pattern)
+		}
+		p.next()
+		if left.typ() != String {
+			return nil, errorf(col, "matches needs a string, got %s", left.typ())
+		}
+		return &matches{left: left, pattern: pattern}, nil
+	}
+	return left, nil
+}
+
+// parsePrimary parses literals, variables, date("...") and parentheses.
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokErr:
+		return nil, errorf(tok.col, "%s", tok.text)
+	case tokNumber:
+		p.next()
+		return &literal{value: tok.num, t: Number}, nil
+	case tokString:
+		p.next()
+		return &literal{value: tok.text, t: String}, nil
+	case tokOp:
+		switch tok.text {
+		case "(":
+			p.next()
+			inner, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return inner, nil
+		case "[":
+			return p.parseList()
+		}
+	case tokIdent:
+		p.next()
+		switch tok.text {
+		case "true", "false":
+			return &literal{value: tok.text == "true", t: Bool}, nil
+		case "in", "matches":
+			return nil, errorf(tok.col, "unexpected %s", tok)
+		case "date":
+			if p.isOp("(") {
+				return p.parseDate()
+			}
+		}
+		t, ok := p.env[tok.text]
+		if !ok {
+			return nil, errorf(tok.col, "unknown variable %q", tok.text)
+		}
+		return &variable{name: tok.text, t: t}, nil
+	}
+	return nil, errorf(tok.col, "unexpected %s", tok)
+}
+
+// parseList parses: "[" (string ("," string)*)? "]"
+func (p *parser) parseList() (node, error) {
+	p.next()
+	var items []string
+	for !p.isOp("]") {
+		if len(items) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		if p.tok.kind != tokString {
+			return nil, errorf(p.tok.col, "lists hold strings, got %s", p.tok)
+		}
+		items = append(items, p.tok.text)
+		p.next()
+	}
+	p.next()
+	return &literal{value: items, t: StringList}, nil
+}
+
+// parseDate parses the rest of: date("2006-01-02")
+func (p *parser) parseDate() (node, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokString {
+		return nil, errorf(p.tok.col, "date needs a quoted YYYY-MM-DD, got %s", p.tok)
+	}
+	t, err := time.Parse(time.DateOnly, p.tok.text)
+	if err != nil {
+		return nil, errorf(p.tok.col, "date needs YYYY-MM-DD, got %q", p.tok.text)
+	}
+	p.next()
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return &literal{value: t, t: Date}, nil
+}
+
+func checkBools(col int, op string, left, right node) error {
+	if left.typ() != Bool || right.typ() != Bool {
+		return errorf(col, "%s needs bools, got %s and %s", op, left.typ(), right.typ())
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+const restAPIBaseURL = "https://api.github.com"
+
+// errAdvisoriesUnavailable means a repo doesn't expose its advisories:
+// private vulnerability reporting is off, or the token may not list them.
+var errAdvisoriesUnavailable = errors.New("advisories not available")
+
+// SecurityAdvisoryStrategy finds published repository security advisories
+// that credit the user. GitHub has no search for advisory credits, so each
+// repository the user is active in is checked through the REST API.
+type SecurityAdvisoryStrategy struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewSecurityAdvisoryStrategy(client *http.Client) *SecurityAdvisoryStrategy {
+	return &SecurityAdvisoryStrategy{client: client, baseURL: restAPIBaseURL}
+}
+
+type repositoryAdvisory struct {
+	GHSAID          string    `json:"ghsa_id"`
+	CVEID           string    `json:"cve_id"`
+	HTMLURL         string    `json:"html_url"`
+	Summary         string    `json:"summary"`
+	Severity        string    `json:"severity"`
+	PublishedAt     time.Time `json:"published_at"`
+	Vulnerabilities []struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+		} `json:"package"`
+	} `json:"vulnerabilities"`
+	CreditsDetailed []struct {
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		Type string `json:"type"`
+	} `json:"credits_detailed"`
+}
+
+func (s *SecurityAdvisoryStrategy) FetchForRepos(ctx context.Context, username string, repos []string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
+	var events []domain.ContributionEvent
+	for _, repo := range repos {
+		advisories, err := s.listPublished(ctx, repo)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if !errors.Is(err, errAdvisoriesUnavailable) {
+				fmt.Printf("Warning: skipping security advisories for %s: %v\n", repo, err)
+			}
+			continue
+		}
+
+		for _, a := range advisories {
+			creditType, ok := creditFor(a, username)
+			if !ok || !window.Contains(a.PublishedAt) {
+				continue
+			}
+			events = append(events, domain.ContributionEvent{
+				ID:        "advisory:" + a.GHSAID,
+				Type:      domain.ContributionTypeSecurityAdvisory,
+				Repo:      repo,
+				URL:       a.HTMLURL,
+				Title:     a.Summary,
+				CreatedAt: a.PublishedAt,
+				Advisory: &domain.AdvisoryCredit{
+					GHSAID:     a.GHSAID,
+					CVEID:      a.CVEID,
+					Severity:   normalizeSeverity(a.Severity),
+					Ecosystems: ecosystems(a),
+					CreditType: creditType,
+				},
+			})
+		}
+	}
+	return events, nil
+}
+
+func (s *SecurityAdvisoryStrategy) Name() domain.ContributionType {
+	return domain.ContributionTypeSecurityAdvisory
+}
+
+// listPublished returns every published advisory of repo, following the
+// Link header from page to page. A repo that doesn't expose advisories
+// returns errAdvisoriesUnavailable.
+func (s *SecurityAdvisoryStrategy) listPublished(ctx context.Context, repo string) ([]repositoryAdvisory, error) {
+	var advisories []repositoryAdvisory
+	url := fmt.Sprintf("%s/repos/%s/security-advisories?state=published&per_page=100", s.baseURL, repo)
+	for url != "" {
+		page, next, err := s.listPage(ctx, repo, url)
+		if err != nil {
+			return nil, err
+		}
+		advisories = append(advisories, page...)
+		url = next
+	}
+	return advisories, nil
+}
+
+func (s *SecurityAdvisoryStrategy) listPage(ctx context.Context, repo, url string) ([]repositoryAdvisory, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("building advisories request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("listing advisories for %s: %w", repo, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusNotFound, resp.StatusCode == http.StatusForbidden && !isRateLimited(resp):
+		return nil, "", fmt.Errorf("listing advisories for %s: %w (%s)", repo, errAdvisoriesUnavailable, resp.Status)
+	default:
+		return nil, "", fmt.Errorf("listing advisories for %s: unexpected status %s", repo, resp.Status)
+	}
+
+	var advisories []repositoryAdvisory
+	if err := json.NewDecoder(resp.Body).Decode(&advisories); err != nil {
+		return nil, "", fmt.Errorf("decoding advisories for %s: %w", repo, err)
+	}
+	return advisories, nextPageURL(resp.Header.Get("Link")), nil
+}
+
+// nextPageURL returns the rel="next" target of a REST Link header, or "" on
+// the last page.
+func nextPageURL(link string) string {
+	for part := range strings.SplitSeq(link, ",") {
+		target, params, ok := strings.Cut(part, ";")
+		if !ok {
+			continue
+		}
+		for param := range strings.SplitSeq(params, ";") {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(target), "<>")
+			}
+		}
+	}
+	return ""
+}
+
+func creditFor(a repositoryAdvisory, username string) (string, bool) {
+	for _, c := range a.CreditsDetailed {
+		if strings.EqualFold(c.User.Login, username) {
+			return c.Type, true
+		}
+	}
+	return "", false
+}
+
+func normalizeSeverity(severity string) domain.AdvisorySeverity {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL":
+		return domain.AdvisorySeverityCritical
+	case "HIGH":
+		return domain.AdvisorySeverityHigh
+	case "MEDIUM", "MODERATE":
+		return domain.AdvisorySeverityMedium
+	case "LOW":
+		return domain.AdvisorySeverityLow
+	default:
+		return ""
+	}
+}
+
+func ecosystems(a repositoryAdvisory) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, v := range a.Vulnerabilities {
+		eco := v.Package.Ecosystem
+		if eco == "" || seen[eco] {
+			continue
+		}
+		seen[eco] = true
+		out = append(out, eco)
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNextPageURL(t *testing.T) {
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{"empty", "", ""},
+		{"next and last", `<https://api.github.com/x?page=2>; rel="next", <https://api.github.com/x?page=5>; rel="last"`, "https://api.github.com/x?page=2"},
+		{"last page", `<https://api.github.com/x?page=1>; rel="first", <https://api.github.com/x?page=4>; rel="prev"`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextPageURL(tt.link); got != tt.want {
+				t.Errorf("nextPageURL(%q) = %q, want %q", tt.link, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListPublished_FollowsPages(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/up/stream/security-advisories":
+			if r.URL.Query().Get("page") == "" {
+				w.Header().Set("Link", "<"+srv.URL+r.URL.Path+`?page=2>; rel="next"`)
+				json.NewEncoder(w).Encode([]repositoryAdvisory{{GHSAID: "GHSA-new"}}) //nolint:errcheck
+				return
+			}
+			json.NewEncoder(w).Encode([]repositoryAdvisory{{GHSAID: "GHSA-old"}}) //nolint:errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	s := &SecurityAdvisoryStrategy{client: srv.Client(), baseURL: srv.URL}
+
+	advisories, err := s.listPublished(context.Background(), "up/stream")
+	if err != nil {
+		t.Fatalf("listPublished failed: %v", err)
+	}
+	if len(advisories) != 2 || advisories[1].GHSAID != "GHSA-old" {
+		t.Errorf("expected advisories from both pages, got %+v", advisories)
+	}
+
+	if _, err := s.listPublished(context.Background(), "no/advisories"); !errors.Is(err, errAdvisoriesUnavailable) {
+		t.Errorf("expected a 404 to mean advisories are unavailable, got %v", err)
+	}
+}
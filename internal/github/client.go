@@ -3,29 +3,59 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"slices"
+	"sort"
 
 	"github.com/arayofcode/footprint/internal/domain"
 	"github.com/shurcooL/githubv4"
 )
 
 type Client struct {
-	gv4        *githubv4.Client
-	strategies []domain.ContributionStrategy
+	gv4            *githubv4.Client
+	strategies     []domain.ContributionStrategy
+	repoStrategies []domain.RepoScopedStrategy
 }
 
-func NewClient(gv4Client *githubv4.Client) *Client {
-	return &Client{
+// ClientOptions turns on the data sources that cost extra queries.
+type ClientOptions struct {
+	PRFiles bool // Fetch the size and files of authored PRs, for trivial PR detection
+	Triage  bool // Walk recently updated issue and PR timelines for triage actions
+
+	// Advisories lists the published security advisories of every repo the
+	// user contributes to, one REST call per page per repo.
+	Advisories bool
+
+	// Releases walks the release history of repos where the user has a
+	// merged PR, for releases they authored.
+	Releases bool
+}
+
+// NewClient builds a client for the GraphQL API. httpClient must carry the
+// same credentials and is used for the few REST-only endpoints.
+func NewClient(gv4Client *githubv4.Client, httpClient *http.Client, opts ClientOptions) *Client {
+	c := &Client{
 		gv4: gv4Client,
 		strategies: []domain.ContributionStrategy{
-			NewPullRequestAuthoredStrategy(gv4Client),
+			NewPullRequestAuthoredStrategy(gv4Client, opts.PRFiles),
 			NewPullRequestReviewedStrategy(gv4Client),
 			NewIssueAuthoredStrategy(gv4Client),
 			NewIssueCommentsStrategy(gv4Client),
 		},
 	}
+	if opts.Releases {
+		c.repoStrategies = append(c.repoStrategies, NewReleaseAuthoredStrategy(gv4Client))
+	}
+	if opts.Advisories {
+		c.repoStrategies = append(c.repoStrategies, NewSecurityAdvisoryStrategy(httpClient))
+	}
+	if opts.Triage {
+		c.repoStrategies = append(c.repoStrategies, NewTriageStrategy(gv4Client))
+	}
+	return c
 }
 
-func (c *Client) FetchExternalContributions(ctx context.Context, username string) (domain.User, []domain.ContributionEvent, error) {
+func (c *Client) FetchExternalContributions(ctx context.Context, username string, window domain.TimeWindow) (domain.User, []domain.ContributionEvent, error) {
 	user, err := c.fetchUser(ctx, username)
 
 	if err != nil {
@@ -35,24 +65,71 @@ func (c *Client) FetchExternalContributions(ctx context.Context, username string
 	// Fetch contributions using strategies
 	eventMap := make(map[string]domain.ContributionEvent)
 	uniqueRepos := make(map[string]bool)
+	mergedRepos := make(map[string]bool)
 
 	for _, strategy := range c.strategies {
-		events, err := strategy.Fetch(ctx, username)
+		events, err := strategy.Fetch(ctx, username, window)
 		if err != nil {
 			continue
 		}
 
 		for _, e := range events {
 			uniqueRepos[e.Repo] = true
+			if e.Type == domain.ContributionTypePR && e.Merged {
+				mergedRepos[e.Repo] = true
+			}
+			if _, ok := eventMap[e.ID]; !ok {
+				eventMap[e.ID] = e
+			}
+		}
+	}
+
+	// Repo-scoped strategies only see repos found above. They inherit the
+	// popularity signals of the first event seen for each repo.
+	repoInfo := make(map[string]domain.ContributionEvent)
+	for _, e := range eventMap {
+		if _, ok := repoInfo[e.Repo]; !ok {
+			repoInfo[e.Repo] = e
+		}
+	}
+	repos := make([]string, 0, len(uniqueRepos))
+	for repo := range uniqueRepos {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	for _, strategy := range c.repoStrategies {
+		scope := repos
+		if _, ok := strategy.(*ReleaseAuthoredStrategy); ok {
+			// Release history is long; only repos the user has landed a PR
+			// in are likely to have releases they cut
+			scope = slices.DeleteFunc(slices.Clone(repos), func(repo string) bool { return !mergedRepos[repo] })
+		}
+		events, err := strategy.FetchForRepos(ctx, username, scope, window)
+		if err != nil {
+			continue
+		}
+
+		for _, e := range events {
+			if info, ok := repoInfo[e.Repo]; ok {
+				e.Stars = info.Stars
+				e.Forks = info.Forks
+				e.RepoOwnerAvatarURL = info.RepoOwnerAvatarURL
+				e.Language, e.LanguageColor = info.Language, info.LanguageColor
+			}
 			if _, ok := eventMap[e.ID]; !ok {
 				eventMap[e.ID] = e
 			}
 		}
 	}
 
+	// Strategies push the window down where the API allows; this catches
+	// whatever they could only narrow down approximately.
 	allEvents := make([]domain.ContributionEvent, 0, len(eventMap))
 	for _, e := range eventMap {
-		allEvents = append(allEvents, e)
+		if window.Contains(e.CreatedAt) {
+			allEvents = append(allEvents, e)
+		}
 	}
 
 	return user, allEvents, nil
@@ -106,11 +183,13 @@ func (c *Client) FetchOwnedProjects(ctx context.Context, username string) ([]dom
 			}
 
 			projects = append(projects, domain.OwnedProject{
-				Repo:      repo.NameWithOwner,
-				URL:       repo.URL.String(),
-				AvatarURL: repo.Owner.AvatarURL.String(),
-				Stars:     repo.StargazerCount,
-				Forks:     repo.ForkCount,
+				Repo:          repo.NameWithOwner,
+				URL:           repo.URL.String(),
+				AvatarURL:     repo.Owner.AvatarURL.String(),
+				Stars:         repo.StargazerCount,
+				Forks:         repo.ForkCount,
+				Language:      repo.PrimaryLanguage.name(),
+				LanguageColor: repo.PrimaryLanguage.color(),
 			})
 		}
 
@@ -127,13 +206,14 @@ type ownedRepoQuery struct {
 	User struct {
 		Repositories struct {
 			Nodes []struct {
-				NameWithOwner  string
-				URL            githubv4.URI
-				StargazerCount int
-				ForkCount      int
-				IsFork         bool
-				IsPrivate      bool
-				Owner          struct {
+				NameWithOwner   string
+				URL             githubv4.URI
+				StargazerCount  int
+				ForkCount       int
+				PrimaryLanguage *repoLanguage
+				IsFork          bool
+				IsPrivate       bool
+				Owner           struct {
 					AvatarURL githubv4.URI `graphql:"avatarUrl"`
 				}
 			}
@@ -145,3 +225,24 @@ type ownedRepoQuery struct {
 		AvatarURL githubv4.URI `graphql:"avatarUrl"`
 	} `graphql:"user(login: $login)"`
 }
+
+// repoLanguage is a repository's primaryLanguage, nil when linguist detected
+// none.
+type repoLanguage struct {
+	Name  string
+	Color string
+}
+
+func (l *repoLanguage) name() string {
+	if l == nil {
+		return ""
+	}
+	return l.Name
+}
+
+func (l *repoLanguage) color() string {
+	if l == nil {
+		return ""
+	}
+	return l.Color
+}
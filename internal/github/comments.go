@@ -16,12 +16,14 @@ type issueCommentSearchQuery struct {
 				ID         string
 				URL        string
 				CreatedAt  githubv4.DateTime
+				UpdatedAt  githubv4.DateTime
 				Repository struct {
-					NameWithOwner  string
-					StargazerCount int
-					ForkCount      int
-					IsPrivate      bool
-					Owner          struct {
+					NameWithOwner   string
+					StargazerCount  int
+					ForkCount       int
+					PrimaryLanguage *repoLanguage
+					IsPrivate       bool
+					Owner           struct {
 						AvatarURL githubv4.URI `graphql:"avatarUrl"`
 					}
 				}
@@ -29,19 +31,20 @@ type issueCommentSearchQuery struct {
 					Typename githubv4.String `graphql:"__typename"`
 					Title    string
 				}
-				Reactions struct {
-					TotalCount int
-				} `graphql:"reactions(content: THUMBS_UP)"`
+				ReactionGroups []reactionGroup
 			}
 			PageInfo struct {
 				EndCursor   githubv4.String
 				HasNextPage bool
 			}
-		} `graphql:"issueComments(first: 100, after: $cursor)"`
+		} `graphql:"issueComments(first: 100, after: $cursor, orderBy: {field: UPDATED_AT, direction: DESC})"`
 	} `graphql:"user(login: $username)"`
 }
 
-func searchIssueComments(ctx context.Context, client *githubv4.Client, username string) ([]domain.ContributionEvent, error) {
+// searchIssueComments walks the user's comments, most recently updated first.
+// A comment is never updated before it is created, so the walk stops at the
+// first comment last updated before the window starts.
+func searchIssueComments(ctx context.Context, client *githubv4.Client, username string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
 	var allEvents []domain.ContributionEvent
 	variables := map[string]any{
 		"username": githubv4.String(username),
@@ -55,11 +58,17 @@ func searchIssueComments(ctx context.Context, client *githubv4.Client, username
 			return nil, fmt.Errorf("graphql search error: %w", err)
 		}
 
+		ended := false
 		for _, node := range q.User.IssueComments.Nodes {
-			if node.Repository.IsPrivate {
+			if window.Ended(node.UpdatedAt.Time) {
+				ended = true
+				break
+			}
+			if node.Repository.IsPrivate || !window.Contains(node.CreatedAt.Time) {
 				continue
 			}
 			cType := domain.ContributionTypeIssueComment
+			reactions, reactionsCount := reactionBreakdown(node.ReactionGroups)
 			event := domain.ContributionEvent{
 				ID:                 node.ID,
 				Type:               cType,
@@ -69,13 +78,16 @@ func searchIssueComments(ctx context.Context, client *githubv4.Client, username
 				CreatedAt:          node.CreatedAt.Time,
 				Stars:              node.Repository.StargazerCount,
 				Forks:              node.Repository.ForkCount,
-				ReactionsCount:     node.Reactions.TotalCount,
+				Language:           node.Repository.PrimaryLanguage.name(),
+				LanguageColor:      node.Repository.PrimaryLanguage.color(),
+				ReactionsCount:     reactionsCount,
+				Reactions:          reactions,
 				RepoOwnerAvatarURL: node.Repository.Owner.AvatarURL.String(),
 			}
 			allEvents = append(allEvents, event)
 		}
 
-		if !q.User.IssueComments.PageInfo.HasNextPage {
+		if ended || !q.User.IssueComments.PageInfo.HasNextPage {
 			break
 		}
 		variables["cursor"] = githubv4.NewString(q.User.IssueComments.PageInfo.EndCursor)
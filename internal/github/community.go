@@ -0,0 +1,240 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/shurcooL/githubv4"
+)
+
+// maxCommunityPages bounds how many pages of pull requests and issues are
+// walked per repo (50 items per page), newest first.
+const maxCommunityPages = 10
+
+type communityAuthor struct {
+	Typename  githubv4.String `graphql:"__typename"`
+	Login     string
+	AvatarURL githubv4.URI `graphql:"avatarUrl"`
+}
+
+type communityRepo struct {
+	StargazerCount  int
+	ForkCount       int
+	PrimaryLanguage *repoLanguage
+	Owner           struct {
+		AvatarURL githubv4.URI `graphql:"avatarUrl"`
+	}
+}
+
+type communityPullRequestsQuery struct {
+	Repository struct {
+		communityRepo
+		PullRequests struct {
+			Nodes []struct {
+				ID                string
+				Title             string
+				URL               string
+				CreatedAt         githubv4.DateTime
+				Merged            bool
+				MergedAt          *githubv4.DateTime
+				AuthorAssociation githubv4.CommentAuthorAssociation
+				Author            communityAuthor
+				ReactionGroups    []reactionGroup
+				Reviews           struct {
+					Nodes []struct {
+						ID        string
+						URL       string
+						CreatedAt githubv4.DateTime
+						Author    communityAuthor
+					}
+				} `graphql:"reviews(first: 20)"`
+			}
+			PageInfo triagePageInfo
+		} `graphql:"pullRequests(first: 50, after: $cursor, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type communityIssuesQuery struct {
+	Repository struct {
+		communityRepo
+		Issues struct {
+			Nodes []struct {
+				ID                string
+				Title             string
+				URL               string
+				CreatedAt         githubv4.DateTime
+				AuthorAssociation githubv4.CommentAuthorAssociation
+				Author            communityAuthor
+				ReactionGroups    []reactionGroup
+			}
+			PageInfo triagePageInfo
+		} `graphql:"issues(first: 50, after: $cursor, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// FetchRepoActivity lists recent pull requests, reviews and issues in a repo
+// by everyone, grouped by author. Bots and deleted accounts are skipped. Pull
+// requests and issues are kept when opened within window, reviews when
+// submitted within it.
+func (c *Client) FetchRepoActivity(ctx context.Context, repo string, window domain.TimeWindow) (domain.RepoActivity, error) {
+	owner, name, ok := splitRepo(repo)
+	if !ok {
+		return domain.RepoActivity{}, fmt.Errorf("invalid repo %q, expected owner/name", repo)
+	}
+
+	activity := domain.RepoActivity{Repo: repo}
+	contributors := make(map[string]*domain.ContributorActivity)
+	record := func(author communityAuthor, event domain.ContributionEvent) {
+		if !isPerson(author) {
+			return
+		}
+		key := strings.ToLower(author.Login)
+		if contributors[key] == nil {
+			contributors[key] = &domain.ContributorActivity{
+				User: domain.User{Username: author.Login, AvatarURL: author.AvatarURL.String()},
+			}
+		}
+		contributors[key].Events = append(contributors[key].Events, event)
+	}
+
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"cursor": (*githubv4.String)(nil),
+	}
+	for page := 0; page < maxCommunityPages; page++ {
+		var q communityPullRequestsQuery
+		if err := c.gv4.Query(ctx, &q, variables); err != nil {
+			return domain.RepoActivity{}, fmt.Errorf("listing pull requests for %s: %w", repo, err)
+		}
+
+		r := q.Repository.communityRepo
+		ended := false
+		for _, pr := range q.Repository.PullRequests.Nodes {
+			if window.Ended(pr.CreatedAt.Time) {
+				ended = true
+				break
+			}
+			if !window.Contains(pr.CreatedAt.Time) {
+				continue
+			}
+			reactions, reactionsCount := reactionBreakdown(pr.ReactionGroups)
+			record(pr.Author, domain.ContributionEvent{
+				ID:                 pr.ID,
+				Type:               domain.ContributionTypePR,
+				Repo:               repo,
+				URL:                pr.URL,
+				Title:              pr.Title,
+				CreatedAt:          pr.CreatedAt.Time,
+				Stars:              r.StargazerCount,
+				Forks:              r.ForkCount,
+				Language:           r.PrimaryLanguage.name(),
+				LanguageColor:      r.PrimaryLanguage.color(),
+				Merged:             pr.Merged,
+				MergedAt:           optionalTime(pr.MergedAt),
+				AuthorAssociation:  string(pr.AuthorAssociation),
+				ReactionsCount:     reactionsCount,
+				Reactions:          reactions,
+				RepoOwnerAvatarURL: r.Owner.AvatarURL.String(),
+			})
+
+			pull := domain.PullRequestActivity{
+				Repo:      repo,
+				Author:    pr.Author.Login,
+				CreatedAt: pr.CreatedAt.Time,
+				Merged:    pr.Merged,
+			}
+			for _, review := range pr.Reviews.Nodes {
+				// Replies to review threads show up as reviews by the author
+				if !isPerson(review.Author) || strings.EqualFold(review.Author.Login, pr.Author.Login) || !window.Contains(review.CreatedAt.Time) {
+					continue
+				}
+				pull.Reviews = append(pull.Reviews, domain.PullRequestReview{
+					Reviewer:  domain.User{Username: review.Author.Login, AvatarURL: review.Author.AvatarURL.String()},
+					CreatedAt: review.CreatedAt.Time,
+				})
+				record(review.Author, domain.ContributionEvent{
+					ID:                 review.ID,
+					Type:               domain.ContributionTypeReview,
+					Repo:               repo,
+					URL:                review.URL,
+					Title:              pr.Title,
+					CreatedAt:          review.CreatedAt.Time,
+					Stars:              r.StargazerCount,
+					Forks:              r.ForkCount,
+					Language:           r.PrimaryLanguage.name(),
+					LanguageColor:      r.PrimaryLanguage.color(),
+					RepoOwnerAvatarURL: r.Owner.AvatarURL.String(),
+				})
+			}
+			if isPerson(pr.Author) {
+				activity.PullRequests = append(activity.PullRequests, pull)
+			}
+		}
+
+		if ended || !q.Repository.PullRequests.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(q.Repository.PullRequests.PageInfo.EndCursor)
+	}
+
+	variables["cursor"] = (*githubv4.String)(nil)
+	for page := 0; page < maxCommunityPages; page++ {
+		var q communityIssuesQuery
+		if err := c.gv4.Query(ctx, &q, variables); err != nil {
+			return domain.RepoActivity{}, fmt.Errorf("listing issues for %s: %w", repo, err)
+		}
+
+		r := q.Repository.communityRepo
+		ended := false
+		for _, issue := range q.Repository.Issues.Nodes {
+			if window.Ended(issue.CreatedAt.Time) {
+				ended = true
+				break
+			}
+			if !window.Contains(issue.CreatedAt.Time) {
+				continue
+			}
+			reactions, reactionsCount := reactionBreakdown(issue.ReactionGroups)
+			record(issue.Author, domain.ContributionEvent{
+				ID:                 issue.ID,
+				Type:               domain.ContributionTypeIssue,
+				Repo:               repo,
+				URL:                issue.URL,
+				Title:              issue.Title,
+				CreatedAt:          issue.CreatedAt.Time,
+				Stars:              r.StargazerCount,
+				Forks:              r.ForkCount,
+				Language:           r.PrimaryLanguage.name(),
+				LanguageColor:      r.PrimaryLanguage.color(),
+				AuthorAssociation:  string(issue.AuthorAssociation),
+				ReactionsCount:     reactionsCount,
+				Reactions:          reactions,
+				RepoOwnerAvatarURL: r.Owner.AvatarURL.String(),
+			})
+		}
+
+		if ended || !q.Repository.Issues.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(q.Repository.Issues.PageInfo.EndCursor)
+	}
+
+	for _, c := range contributors {
+		activity.Contributors = append(activity.Contributors, *c)
+	}
+	sort.Slice(activity.Contributors, func(i, j int) bool {
+		return activity.Contributors[i].User.Username < activity.Contributors[j].User.Username
+	})
+
+	return activity, nil
+}
+
+// isPerson reports whether an author is a user account. Bots and deleted
+// ("ghost") accounts come back as other types or without a login.
+func isPerson(author communityAuthor) bool {
+	return author.Typename == "User" && author.Login != ""
+}
@@ -16,9 +16,9 @@ func NewIssueAuthoredStrategy(client *githubv4.Client) *IssueAuthoredStrategy {
 	return &IssueAuthoredStrategy{client: client}
 }
 
-func (s *IssueAuthoredStrategy) Fetch(ctx context.Context, username string) ([]domain.ContributionEvent, error) {
-	query := fmt.Sprintf("author:%s -user:%s type:issue", username, username)
-	events, _, err := searchWithCount(ctx, s.client, query)
+func (s *IssueAuthoredStrategy) Fetch(ctx context.Context, username string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
+	query := fmt.Sprintf("author:%s -user:%s type:issue", username, username) + createdQualifier(window)
+	events, _, err := searchWithCount(ctx, s.client, query, false)
 	if err != nil {
 		return nil, err
 	}
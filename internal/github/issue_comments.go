@@ -15,8 +15,8 @@ func NewIssueCommentsStrategy(client *githubv4.Client) *IssueCommentsStrategy {
 	return &IssueCommentsStrategy{client: client}
 }
 
-func (s *IssueCommentsStrategy) Fetch(ctx context.Context, username string) ([]domain.ContributionEvent, error) {
-	events, err := searchIssueComments(ctx, s.client, username)
+func (s *IssueCommentsStrategy) Fetch(ctx context.Context, username string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
+	events, err := searchIssueComments(ctx, s.client, username, window)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,73 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+)
+
+type memberNodes struct {
+	Nodes []struct {
+		Login string
+	}
+	PageInfo struct {
+		EndCursor   githubv4.String
+		HasNextPage bool
+	}
+}
+
+type orgMembersQuery struct {
+	Organization struct {
+		MembersWithRole memberNodes `graphql:"membersWithRole(first: 100, after: $cursor)"`
+	} `graphql:"organization(login: $org)"`
+}
+
+type teamMembersQuery struct {
+	Organization struct {
+		Team struct {
+			Members memberNodes `graphql:"members(first: 100, after: $cursor, membership: ALL)"`
+		} `graphql:"team(slug: $team)"`
+	} `graphql:"organization(login: $org)"`
+}
+
+// FetchMembers lists the logins of an org, or of one of its teams when team
+// is set. Tokens from outside the org only see members with public membership.
+func (c *Client) FetchMembers(ctx context.Context, org, team string) ([]string, error) {
+	var members []string
+	variables := map[string]any{
+		"org":    githubv4.String(org),
+		"cursor": (*githubv4.String)(nil),
+	}
+	if team != "" {
+		variables["team"] = githubv4.String(team)
+	}
+
+	for {
+		var page memberNodes
+		if team != "" {
+			var q teamMembersQuery
+			if err := c.gv4.Query(ctx, &q, variables); err != nil {
+				return nil, fmt.Errorf("listing members of team %s/%s: %w", org, team, err)
+			}
+			page = q.Organization.Team.Members
+		} else {
+			var q orgMembersQuery
+			if err := c.gv4.Query(ctx, &q, variables); err != nil {
+				return nil, fmt.Errorf("listing members of org %s: %w", org, err)
+			}
+			page = q.Organization.MembersWithRole
+		}
+
+		for _, n := range page.Nodes {
+			members = append(members, n.Login)
+		}
+
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(page.PageInfo.EndCursor)
+	}
+
+	return members, nil
+}
@@ -9,16 +9,19 @@ import (
 )
 
 type PullRequestAuthoredStrategy struct {
-	client *githubv4.Client
+	client    *githubv4.Client
+	withFiles bool
 }
 
-func NewPullRequestAuthoredStrategy(client *githubv4.Client) *PullRequestAuthoredStrategy {
-	return &PullRequestAuthoredStrategy{client: client}
+// NewPullRequestAuthoredStrategy searches for PRs the user opened. withFiles
+// also fetches their size and changed files, which trivial PR detection needs.
+func NewPullRequestAuthoredStrategy(client *githubv4.Client, withFiles bool) *PullRequestAuthoredStrategy {
+	return &PullRequestAuthoredStrategy{client: client, withFiles: withFiles}
 }
 
-func (s *PullRequestAuthoredStrategy) Fetch(ctx context.Context, username string) ([]domain.ContributionEvent, error) {
-	query := fmt.Sprintf("author:%s -user:%s type:pr", username, username)
-	events, _, err := searchWithCount(ctx, s.client, query)
+func (s *PullRequestAuthoredStrategy) Fetch(ctx context.Context, username string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
+	query := fmt.Sprintf("author:%s -user:%s type:pr", username, username) + createdQualifier(window)
+	events, _, err := searchWithCount(ctx, s.client, query, s.withFiles)
 	if err != nil {
 		return nil, err
 	}
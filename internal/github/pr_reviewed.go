@@ -16,9 +16,11 @@ func NewPullRequestReviewedStrategy(client *githubv4.Client) *PullRequestReviewe
 	return &PullRequestReviewedStrategy{client: client}
 }
 
-func (s *PullRequestReviewedStrategy) Fetch(ctx context.Context, username string) ([]domain.ContributionEvent, error) {
-	query := fmt.Sprintf("reviewer:%s -user:%s type:pr", username, username)
-	events, _, err := searchWithCount(ctx, s.client, query)
+func (s *PullRequestReviewedStrategy) Fetch(ctx context.Context, username string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
+	// Search cannot filter by review date, so the window applies to when the
+	// PR was opened, which is also the time the review event is given.
+	query := fmt.Sprintf("reviewer:%s -user:%s type:pr", username, username) + createdQualifier(window)
+	events, _, err := searchWithCount(ctx, s.client, query, false)
 	if err != nil {
 		return nil, err
 	}
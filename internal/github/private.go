@@ -0,0 +1,126 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/shurcooL/githubv4"
+)
+
+type repoContributionCount struct {
+	Repository struct {
+		IsPrivate bool
+	}
+	Contributions struct {
+		TotalCount int
+	}
+}
+
+type privateContributionsQuery struct {
+	User struct {
+		ContributionsCollection struct {
+			RestrictedContributionsCount               int
+			CommitContributionsByRepository            []repoContributionCount `graphql:"commitContributionsByRepository(maxRepositories: 100)"`
+			IssueContributionsByRepository             []repoContributionCount `graphql:"issueContributionsByRepository(maxRepositories: 100)"`
+			PullRequestContributionsByRepository       []repoContributionCount `graphql:"pullRequestContributionsByRepository(maxRepositories: 100)"`
+			PullRequestReviewContributionsByRepository []repoContributionCount `graphql:"pullRequestReviewContributionsByRepository(maxRepositories: 100)"`
+		} `graphql:"contributionsCollection(from: $from, to: $to)"`
+	} `graphql:"user(login: $login)"`
+}
+
+type contributionYearsQuery struct {
+	User struct {
+		ContributionsCollection struct {
+			ContributionYears []int
+		}
+	} `graphql:"user(login: $login)"`
+}
+
+// FetchPrivateContributions sums contributionsCollection counts for private
+// repositories only. Repository identities are discarded as soon as the
+// counts are summed. Contributions the token cannot see are reported by GitHub
+// as restrictedContributionsCount.
+//
+// A contributionsCollection spans at most a year, so the window is queried a
+// year at a time. An open start begins with the user's first contribution
+// year.
+func (c *Client) FetchPrivateContributions(ctx context.Context, username string, window domain.TimeWindow) (domain.PrivateContributions, error) {
+	start, end := window.Since, window.Until
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if start.IsZero() {
+		year, err := c.firstContributionYear(ctx, username)
+		if err != nil {
+			return domain.PrivateContributions{}, err
+		}
+		start = time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	var total domain.PrivateContributions
+	for _, r := range privateRanges(start, end) {
+		var q privateContributionsQuery
+		variables := map[string]any{
+			"login": githubv4.String(username),
+			"from":  &githubv4.DateTime{Time: r[0]},
+			"to":    &githubv4.DateTime{Time: r[1]},
+		}
+		if err := c.gv4.Query(ctx, &q, variables); err != nil {
+			return domain.PrivateContributions{}, fmt.Errorf("fetching private contributions from %s: %w", r[0].Format("2006-01-02"), err)
+		}
+
+		collection := q.User.ContributionsCollection
+		total.Restricted += collection.RestrictedContributionsCount
+		total.Commits += sumPrivate(collection.CommitContributionsByRepository)
+		total.Issues += sumPrivate(collection.IssueContributionsByRepository)
+		total.PullRequests += sumPrivate(collection.PullRequestContributionsByRepository)
+		total.Reviews += sumPrivate(collection.PullRequestReviewContributionsByRepository)
+	}
+	return total, nil
+}
+
+// firstContributionYear is the earliest year GitHub has contributions for,
+// or the current year for a user without any.
+func (c *Client) firstContributionYear(ctx context.Context, username string) (int, error) {
+	var q contributionYearsQuery
+	variables := map[string]any{
+		"login": githubv4.String(username),
+	}
+	if err := c.gv4.Query(ctx, &q, variables); err != nil {
+		return 0, fmt.Errorf("fetching contribution years: %w", err)
+	}
+	first := time.Now().Year()
+	for _, year := range q.User.ContributionsCollection.ContributionYears {
+		first = min(first, year)
+	}
+	return first, nil
+}
+
+// privateRanges splits [start, end) into consecutive from/to pairs of at most
+// a year each, as contributionsCollection requires. The pairs are inclusive:
+// a range ends a second before the next begins, and the last a second before
+// end, so no contribution is counted twice.
+func privateRanges(start, end time.Time) [][2]time.Time {
+	var ranges [][2]time.Time
+	for from := start; from.Before(end); from = from.AddDate(1, 0, 0) {
+		to := from.AddDate(1, 0, 0)
+		if to.After(end) {
+			to = end
+		}
+		to = to.Add(-time.Second)
+		ranges = append(ranges, [2]time.Time{from, to})
+	}
+	return ranges
+}
+
+func sumPrivate(repos []repoContributionCount) int {
+	total := 0
+	for _, r := range repos {
+		if r.Repository.IsPrivate {
+			total += r.Contributions.TotalCount
+		}
+	}
+	return total
+}
@@ -0,0 +1,52 @@
+package github
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPrivateRanges(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       [][2]time.Time
+	}{
+		{
+			name:  "empty window",
+			start: day(2025, 1, 1), end: day(2025, 1, 1),
+			want: nil,
+		},
+		{
+			name:  "within a year",
+			start: day(2025, 3, 1), end: day(2025, 6, 1),
+			want: [][2]time.Time{{day(2025, 3, 1), day(2025, 6, 1).Add(-time.Second)}},
+		},
+		{
+			name:  "exactly a year",
+			start: day(2025, 1, 1), end: day(2026, 1, 1),
+			want: [][2]time.Time{{day(2025, 1, 1), day(2026, 1, 1).Add(-time.Second)}},
+		},
+		{
+			name:  "split by year",
+			start: day(2023, 7, 1), end: day(2025, 2, 1),
+			want: [][2]time.Time{
+				{day(2023, 7, 1), day(2024, 7, 1).Add(-time.Second)},
+				{day(2024, 7, 1), day(2025, 2, 1).Add(-time.Second)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := privateRanges(tt.start, tt.end)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d ranges, got %v", len(tt.want), got)
+			}
+			for i := range got {
+				if !got[i][0].Equal(tt.want[i][0]) || !got[i][1].Equal(tt.want[i][1]) {
+					t.Errorf("range %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
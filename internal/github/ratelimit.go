@@ -0,0 +1,162 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMinRemaining is the primary rate-limit budget kept in reserve.
+	// Once a response reports fewer remaining requests, further requests wait
+	// for the reset.
+	DefaultMinRemaining = 50
+	// DefaultMaxRetries bounds retries of requests rejected by secondary
+	// (abuse) rate limits.
+	DefaultMaxRetries = 3
+	// secondaryLimitBackoff is used when a rejection carries no Retry-After.
+	secondaryLimitBackoff = 60 * time.Second
+)
+
+// RateLimitTransport is an http.RoundTripper shared by every GitHub client in
+// a run. It pauses all requests when the primary rate limit is nearly spent,
+// and retries requests rejected by secondary rate limits after the delay
+// GitHub asks for. Sharing one transport keeps org-wide fetches of many
+// members under a single budget.
+type RateLimitTransport struct {
+	Base         http.RoundTripper
+	MinRemaining int
+	MaxRetries   int
+
+	mu       sync.Mutex
+	resumeAt time.Time
+}
+
+func NewRateLimitTransport(base http.RoundTripper) *RateLimitTransport {
+	return &RateLimitTransport{
+		Base:         base,
+		MinRemaining: DefaultMinRemaining,
+		MaxRetries:   DefaultMaxRetries,
+	}
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if err := sleepCtx(ctx, t.waitDuration()); err != nil {
+			return nil, err
+		}
+
+		attemptReq := req
+		if attempt > 0 {
+			attemptReq = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		resp, err := t.base().RoundTrip(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+		t.observe(resp)
+
+		wait, retry := retryDelay(resp)
+		replayable := req.Body == nil || req.GetBody != nil
+		if !retry || !replayable || attempt >= t.MaxRetries {
+			return resp, nil
+		}
+		resp.Body.Close() //nolint:errcheck
+		if err := sleepCtx(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RateLimitTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+func (t *RateLimitTransport) waitDuration() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return time.Until(t.resumeAt)
+}
+
+// observe records the primary rate-limit state reported by a response.
+func (t *RateLimitTransport) observe(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil || remaining > t.MinRemaining {
+		return
+	}
+	reset, ok := resetTime(resp)
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if reset.After(t.resumeAt) {
+		t.resumeAt = reset
+	}
+}
+
+// retryDelay reports whether a response was rejected by a rate limit, and how
+// long to wait before retrying.
+func retryDelay(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if s := resp.Header.Get("Retry-After"); s != "" {
+		if secs, err := strconv.Atoi(s); err == nil {
+			return time.Duration(secs) * time.Second, true
+		}
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, ok := resetTime(resp); ok {
+			return time.Until(reset), true
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return secondaryLimitBackoff, true
+	}
+	// A plain 403 is a permissions error, not a rate limit
+	return 0, false
+}
+
+// isRateLimited reports whether resp was rejected by a rate limit rather than
+// for lack of permission.
+func isRateLimited(resp *http.Response) bool {
+	_, limited := retryDelay(resp)
+	return limited
+}
+
+func resetTime(resp *http.Response) (time.Time, bool) {
+	epoch, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(epoch, 0), true
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
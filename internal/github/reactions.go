@@ -0,0 +1,32 @@
+package github
+
+import (
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/shurcooL/githubv4"
+)
+
+// reactionGroup is GitHub's per-content reaction summary on a reactable node.
+type reactionGroup struct {
+	Content  githubv4.ReactionContent
+	Reactors struct {
+		TotalCount int
+	}
+}
+
+// reactionBreakdown converts reaction groups into per-content counts and their total.
+// Contents nobody used are omitted.
+func reactionBreakdown(groups []reactionGroup) (map[domain.ReactionContent]int, int) {
+	var breakdown map[domain.ReactionContent]int
+	total := 0
+	for _, g := range groups {
+		if g.Reactors.TotalCount == 0 {
+			continue
+		}
+		if breakdown == nil {
+			breakdown = make(map[domain.ReactionContent]int)
+		}
+		breakdown[domain.ReactionContent(g.Content)] = g.Reactors.TotalCount
+		total += g.Reactors.TotalCount
+	}
+	return breakdown, total
+}
@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/shurcooL/githubv4"
+)
+
+// maxReleasePages bounds how far back release history is walked per repo.
+// Busy projects publish thousands of releases; 5 pages covers the last 500.
+const maxReleasePages = 5
+
+type ReleaseAuthoredStrategy struct {
+	client *githubv4.Client
+}
+
+func NewReleaseAuthoredStrategy(client *githubv4.Client) *ReleaseAuthoredStrategy {
+	return &ReleaseAuthoredStrategy{client: client}
+}
+
+type releaseQuery struct {
+	Repository struct {
+		Releases struct {
+			Nodes []struct {
+				ID          string
+				Name        string
+				TagName     string
+				URL         string
+				IsDraft     bool
+				PublishedAt *githubv4.DateTime
+				CreatedAt   githubv4.DateTime
+				Author      struct {
+					Login string
+				}
+			}
+			PageInfo struct {
+				EndCursor   githubv4.String
+				HasNextPage bool
+			}
+		} `graphql:"releases(first: 100, after: $cursor, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// FetchForRepos finds releases authored by the user in repos they don't own.
+// The client only passes repos where the user has a merged PR.
+func (s *ReleaseAuthoredStrategy) FetchForRepos(ctx context.Context, username string, repos []string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
+	var events []domain.ContributionEvent
+	for _, repo := range repos {
+		owner, name, ok := splitRepo(repo)
+		if !ok || strings.EqualFold(owner, username) {
+			continue
+		}
+
+		releases, err := s.fetchRepoReleases(ctx, username, repo, owner, name, window)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			fmt.Printf("Warning: skipping releases for %s: %v\n", repo, err)
+			continue
+		}
+		events = append(events, releases...)
+	}
+	return events, nil
+}
+
+func (s *ReleaseAuthoredStrategy) fetchRepoReleases(ctx context.Context, username, repo, owner, name string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
+	var events []domain.ContributionEvent
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	for page := 0; page < maxReleasePages; page++ {
+		var q releaseQuery
+		if err := s.client.Query(ctx, &q, variables); err != nil {
+			return nil, fmt.Errorf("listing releases for %s: %w", repo, err)
+		}
+
+		ended := false
+		for _, r := range q.Repository.Releases.Nodes {
+			// Releases are listed newest first
+			if window.Ended(r.CreatedAt.Time) {
+				ended = true
+				break
+			}
+			if r.IsDraft || !strings.EqualFold(r.Author.Login, username) {
+				continue
+			}
+			title := r.Name
+			if title == "" {
+				title = r.TagName
+			}
+			createdAt := r.CreatedAt.Time
+			if r.PublishedAt != nil {
+				createdAt = r.PublishedAt.Time
+			}
+			if !window.Contains(createdAt) {
+				continue
+			}
+			events = append(events, domain.ContributionEvent{
+				ID:        r.ID,
+				Type:      domain.ContributionTypeRelease,
+				Repo:      repo,
+				URL:       r.URL,
+				Title:     title,
+				CreatedAt: createdAt,
+			})
+		}
+
+		if ended || !q.Repository.Releases.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(q.Repository.Releases.PageInfo.EndCursor)
+	}
+
+	return events, nil
+}
+
+func (s *ReleaseAuthoredStrategy) Name() domain.ContributionType {
+	return domain.ContributionTypeRelease
+}
+
+func splitRepo(nameWithOwner string) (owner, name string, ok bool) {
+	return strings.Cut(nameWithOwner, "/")
+}
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/arayofcode/footprint/internal/domain"
 	"github.com/shurcooL/githubv4"
@@ -14,41 +15,68 @@ type searchQuery struct {
 		Nodes      []struct {
 			Typename    githubv4.String `graphql:"__typename"`
 			PullRequest struct {
-				ID         string
-				Title      string
-				URL        string
-				CreatedAt  githubv4.DateTime
-				State      githubv4.PullRequestState
-				Merged     bool
-				Repository struct {
-					NameWithOwner  string
-					StargazerCount int
-					ForkCount      int
-					Owner          struct {
+				ID                string
+				Title             string
+				URL               string
+				CreatedAt         githubv4.DateTime
+				State             githubv4.PullRequestState
+				Merged            bool
+				MergedAt          *githubv4.DateTime
+				AuthorAssociation githubv4.CommentAuthorAssociation
+				Repository        struct {
+					NameWithOwner   string
+					StargazerCount  int
+					ForkCount       int
+					PrimaryLanguage *repoLanguage
+					Owner           struct {
 						AvatarURL githubv4.URI `graphql:"avatarUrl"`
 					}
 				}
-				Reactions struct {
-					TotalCount int
-				} `graphql:"reactions(content: THUMBS_UP)"`
+				// Size and files are only used to flag trivial PRs, and the files
+				// connection adds to the query cost, so both are opt-in.
+				Additions    int `graphql:"additions @include(if: $withFiles)"`
+				Deletions    int `graphql:"deletions @include(if: $withFiles)"`
+				ChangedFiles int `graphql:"changedFiles @include(if: $withFiles)"`
+				Files        struct {
+					Nodes []struct {
+						Path string
+					}
+				} `graphql:"files(first: 50) @include(if: $withFiles)"` // PRs changing more files are never judged docs-only
+				ReactionGroups          []reactionGroup
+				Labels                  labelConnection `graphql:"labels(first: 20)"`
+				ClosingIssuesReferences struct {
+					Nodes []struct {
+						Title      string
+						URL        string
+						CreatedAt  githubv4.DateTime
+						ClosedAt   *githubv4.DateTime
+						Repository struct {
+							NameWithOwner string
+						}
+						Reactions struct {
+							TotalCount int
+						}
+					}
+				} `graphql:"closingIssuesReferences(first: 10)"`
 			} `graphql:"... on PullRequest"`
 			Issue struct {
-				ID         string
-				Title      string
-				URL        string
-				CreatedAt  githubv4.DateTime
-				State      githubv4.IssueState
-				Repository struct {
-					NameWithOwner  string
-					StargazerCount int
-					ForkCount      int
-					Owner          struct {
+				ID                string
+				Title             string
+				URL               string
+				CreatedAt         githubv4.DateTime
+				State             githubv4.IssueState
+				AuthorAssociation githubv4.CommentAuthorAssociation
+				Repository        struct {
+					NameWithOwner   string
+					StargazerCount  int
+					ForkCount       int
+					PrimaryLanguage *repoLanguage
+					Owner           struct {
 						AvatarURL githubv4.URI `graphql:"avatarUrl"`
 					}
 				}
-				Reactions struct {
-					TotalCount int
-				} `graphql:"reactions(content: THUMBS_UP)"`
+				ReactionGroups []reactionGroup
+				Labels         labelConnection `graphql:"labels(first: 20)"`
 			} `graphql:"... on Issue"`
 		}
 		PageInfo struct {
@@ -58,12 +86,15 @@ type searchQuery struct {
 	} `graphql:"search(query: $query, type: ISSUE, first: 100, after: $cursor)"`
 }
 
-func searchWithCount(ctx context.Context, client *githubv4.Client, queryStr string) ([]domain.ContributionEvent, int, error) {
+// searchWithCount runs an issue/PR search. withFiles also fetches each PR's
+// size and changed files, for trivial PR detection.
+func searchWithCount(ctx context.Context, client *githubv4.Client, queryStr string, withFiles bool) ([]domain.ContributionEvent, int, error) {
 	var allEvents []domain.ContributionEvent
 	totalCount := 0
 	variables := map[string]any{
-		"query":  githubv4.String(queryStr),
-		"cursor": (*githubv4.String)(nil),
+		"query":     githubv4.String(queryStr),
+		"cursor":    (*githubv4.String)(nil),
+		"withFiles": githubv4.Boolean(withFiles),
 	}
 
 	for {
@@ -78,32 +109,65 @@ func searchWithCount(ctx context.Context, client *githubv4.Client, queryStr stri
 			switch node.Typename {
 			case "PullRequest":
 				pr := node.PullRequest
+				reactions, reactionsCount := reactionBreakdown(pr.ReactionGroups)
+				var resolved []domain.LinkedIssue
+				for _, issue := range pr.ClosingIssuesReferences.Nodes {
+					resolved = append(resolved, domain.LinkedIssue{
+						Repo:           issue.Repository.NameWithOwner,
+						Title:          issue.Title,
+						URL:            issue.URL,
+						CreatedAt:      issue.CreatedAt.Time,
+						ClosedAt:       optionalTime(issue.ClosedAt),
+						ReactionsCount: issue.Reactions.TotalCount,
+					})
+				}
+				var files []string
+				for _, f := range pr.Files.Nodes {
+					files = append(files, f.Path)
+				}
 				event := domain.ContributionEvent{
 					ID:                 pr.ID,
 					Type:               domain.ContributionTypePR,
 					Repo:               pr.Repository.NameWithOwner,
 					URL:                pr.URL,
 					Title:              pr.Title,
+					Labels:             pr.Labels.names(),
 					CreatedAt:          pr.CreatedAt.Time,
 					Stars:              pr.Repository.StargazerCount,
 					Forks:              pr.Repository.ForkCount,
+					Language:           pr.Repository.PrimaryLanguage.name(),
+					LanguageColor:      pr.Repository.PrimaryLanguage.color(),
+					Additions:          pr.Additions,
+					Deletions:          pr.Deletions,
+					ChangedFiles:       pr.ChangedFiles,
+					Files:              files,
 					Merged:             pr.Merged,
-					ReactionsCount:     pr.Reactions.TotalCount,
+					MergedAt:           optionalTime(pr.MergedAt),
+					AuthorAssociation:  string(pr.AuthorAssociation),
+					ReactionsCount:     reactionsCount,
+					Reactions:          reactions,
+					ResolvedIssues:     resolved,
 					RepoOwnerAvatarURL: pr.Repository.Owner.AvatarURL.String(),
 				}
 				allEvents = append(allEvents, event)
 			case "Issue":
 				issue := node.Issue
+				reactions, reactionsCount := reactionBreakdown(issue.ReactionGroups)
 				event := domain.ContributionEvent{
 					ID:                 issue.ID,
 					Type:               domain.ContributionTypeIssue,
 					Repo:               issue.Repository.NameWithOwner,
 					URL:                issue.URL,
 					Title:              issue.Title,
+					Labels:             issue.Labels.names(),
 					CreatedAt:          issue.CreatedAt.Time,
 					Stars:              issue.Repository.StargazerCount,
 					Forks:              issue.Repository.ForkCount,
-					ReactionsCount:     issue.Reactions.TotalCount,
+					Language:           issue.Repository.PrimaryLanguage.name(),
+					LanguageColor:      issue.Repository.PrimaryLanguage.color(),
+					ReactionsCount:     reactionsCount,
+					Reactions:          reactions,
+					AuthorAssociation:  string(issue.AuthorAssociation),
 					RepoOwnerAvatarURL: issue.Repository.Owner.AvatarURL.String(),
 				}
 				allEvents = append(allEvents, event)
@@ -118,3 +182,42 @@ func searchWithCount(ctx context.Context, client *githubv4.Client, queryStr stri
 
 	return allEvents, totalCount, nil
 }
+
+// labelConnection is the labels of an issue or pull request.
+type labelConnection struct {
+	Nodes []struct {
+		Name string
+	}
+}
+
+func (c labelConnection) names() []string {
+	var names []string
+	for _, n := range c.Nodes {
+		names = append(names, n.Name)
+	}
+	return names
+}
+
+// createdQualifier restricts a search to items created within window, e.g.
+// " created:2025-01-01T00:00:00Z..2025-12-31T23:59:59Z". Search ranges are
+// inclusive, so the exclusive Until is moved back a second.
+func createdQualifier(window domain.TimeWindow) string {
+	const layout = time.RFC3339
+	switch {
+	case window.IsZero():
+		return ""
+	case window.Until.IsZero():
+		return " created:>=" + window.Since.UTC().Format(layout)
+	case window.Since.IsZero():
+		return " created:<" + window.Until.UTC().Format(layout)
+	default:
+		return " created:" + window.Since.UTC().Format(layout) + ".." + window.Until.Add(-time.Second).UTC().Format(layout)
+	}
+}
+
+func optionalTime(t *githubv4.DateTime) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.Time
+}
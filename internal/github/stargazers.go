@@ -0,0 +1,105 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+const (
+	stargazersPerPage = 100
+	// maxStargazerPages is as deep as the REST API pages stargazers.
+	maxStargazerPages = 400
+	// starHistorySamples is how many pages are read per repo. Each page's
+	// first stargazer gives one point of the star count curve.
+	starHistorySamples = 15
+)
+
+// StarHistoryFetcher samples a repo's stargazer starredAt timestamps. GraphQL
+// only pages stargazers by cursor, so this uses the REST API, which can jump
+// to evenly spaced pages.
+type StarHistoryFetcher struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewStarHistoryFetcher(client *http.Client) *StarHistoryFetcher {
+	return &StarHistoryFetcher{client: client, baseURL: restAPIBaseURL}
+}
+
+type stargazer struct {
+	StarredAt time.Time `json:"starred_at"`
+}
+
+// FetchStarHistory reads up to starHistorySamples pages of stargazers,
+// oldest first, and ends the curve at today's count. Repos with more than
+// maxStargazerPages pages of stars are interpolated over the rest.
+func (f *StarHistoryFetcher) FetchStarHistory(ctx context.Context, repo string, stars int) (domain.StarHistory, error) {
+	history := domain.StarHistory{FetchedAt: time.Now()}
+	for _, page := range samplePages(stars) {
+		gazers, err := f.listPage(ctx, repo, page)
+		if err != nil {
+			return domain.StarHistory{}, err
+		}
+		if len(gazers) == 0 {
+			break
+		}
+		history.Samples = append(history.Samples, domain.StarSample{
+			At:    gazers[0].StarredAt,
+			Stars: (page-1)*stargazersPerPage + 1,
+		})
+	}
+	if stars > 0 {
+		history.Samples = append(history.Samples, domain.StarSample{At: history.FetchedAt, Stars: stars})
+	}
+	return history, nil
+}
+
+// samplePages spreads starHistorySamples page numbers evenly over the pages
+// stars fill, always including the first and the last reachable one.
+func samplePages(stars int) []int {
+	pages := min((stars+stargazersPerPage-1)/stargazersPerPage, maxStargazerPages)
+	if pages <= 0 {
+		return nil
+	}
+	n := min(pages, starHistorySamples)
+	if n == 1 {
+		return []int{1}
+	}
+	sampled := make([]int, n)
+	for i := range n {
+		sampled[i] = 1 + i*(pages-1)/(n-1)
+	}
+	return sampled
+}
+
+func (f *StarHistoryFetcher) listPage(ctx context.Context, repo string, page int) ([]stargazer, error) {
+	url := fmt.Sprintf("%s/repos/%s/stargazers?per_page=%d&page=%d", f.baseURL, repo, stargazersPerPage, page)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building stargazers request: %w", err)
+	}
+	// The star media type adds starred_at to each stargazer
+	req.Header.Set("Accept", "application/vnd.github.star+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("listing stargazers for %s: %w", repo, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing stargazers for %s: unexpected status %s", repo, resp.Status)
+	}
+
+	var gazers []stargazer
+	if err := json.NewDecoder(resp.Body).Decode(&gazers); err != nil {
+		return nil, fmt.Errorf("decoding stargazers for %s: %w", repo, err)
+	}
+	return gazers, nil
+}
@@ -0,0 +1,231 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/shurcooL/githubv4"
+)
+
+// maxTriagePages bounds how many pages of recently updated issues and PRs
+// are walked per repo (50 items per page).
+const maxTriagePages = 4
+
+// TriageStrategy finds maintainer actions (labeling, closing, marking
+// duplicates, requesting reviews) the user performed on other people's
+// issues and PRs. Such actions require triage rights, so only repos where the
+// token's viewer is the user and holds them are walked. Only the most
+// recently updated items are read, so older triage can be missed.
+type TriageStrategy struct {
+	client *githubv4.Client
+}
+
+func NewTriageStrategy(client *githubv4.Client) *TriageStrategy {
+	return &TriageStrategy{client: client}
+}
+
+type actorLogin struct {
+	Login string
+}
+
+type triageEventFields struct {
+	ID        string
+	CreatedAt githubv4.DateTime
+	Actor     actorLogin
+}
+
+type triageTimelineItem struct {
+	Typename               githubv4.String   `graphql:"__typename"`
+	LabeledEvent           triageEventFields `graphql:"... on LabeledEvent"`
+	ClosedEvent            triageEventFields `graphql:"... on ClosedEvent"`
+	MarkedAsDuplicateEvent triageEventFields `graphql:"... on MarkedAsDuplicateEvent"`
+	ReviewRequestedEvent   triageEventFields `graphql:"... on ReviewRequestedEvent"`
+}
+
+type triageItem struct {
+	Title         string
+	URL           string
+	UpdatedAt     githubv4.DateTime
+	Author        actorLogin
+	TimelineItems struct {
+		Nodes []triageTimelineItem
+	} `graphql:"timelineItems(first: 50, itemTypes: [LABELED_EVENT, CLOSED_EVENT, MARKED_AS_DUPLICATE_EVENT, REVIEW_REQUESTED_EVENT])"`
+}
+
+type triagePageInfo struct {
+	EndCursor   githubv4.String
+	HasNextPage bool
+}
+
+type triageIssuesQuery struct {
+	Repository struct {
+		ViewerPermission githubv4.RepositoryPermission
+		Issues           struct {
+			Nodes    []triageItem
+			PageInfo triagePageInfo
+		} `graphql:"issues(first: 50, after: $cursor, orderBy: {field: UPDATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type triagePullRequestsQuery struct {
+	Repository struct {
+		PullRequests struct {
+			Nodes    []triageItem
+			PageInfo triagePageInfo
+		} `graphql:"pullRequests(first: 50, after: $cursor, orderBy: {field: UPDATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func (s *TriageStrategy) FetchForRepos(ctx context.Context, username string, repos []string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
+	// viewerPermission only describes the user when the token is theirs.
+	// Without it every repo would be walked blind, which wastes the rate
+	// limit on repos the user can't triage.
+	if !s.viewerIs(ctx, username) {
+		fmt.Printf("Warning: skipping triage actions: the token doesn't belong to %s, so triage rights can't be checked\n", username)
+		return nil, nil
+	}
+
+	var events []domain.ContributionEvent
+	for _, repo := range repos {
+		owner, name, ok := splitRepo(repo)
+		if !ok || strings.EqualFold(owner, username) {
+			continue
+		}
+
+		repoEvents, err := s.fetchRepoTriage(ctx, username, repo, owner, name, window)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			continue
+		}
+		events = append(events, repoEvents...)
+	}
+	return events, nil
+}
+
+func (s *TriageStrategy) Name() domain.ContributionType {
+	return domain.ContributionTypeTriage
+}
+
+func (s *TriageStrategy) viewerIs(ctx context.Context, username string) bool {
+	var q struct {
+		Viewer struct {
+			Login string
+		}
+	}
+	// Installation tokens (e.g. GITHUB_TOKEN) have no viewer
+	if err := s.client.Query(ctx, &q, nil); err != nil {
+		return false
+	}
+	return strings.EqualFold(q.Viewer.Login, username)
+}
+
+// fetchRepoTriage walks issues and then PRs, most recently updated first,
+// stopping at the first item last updated before the window starts.
+func (s *TriageStrategy) fetchRepoTriage(ctx context.Context, username, repo, owner, name string, window domain.TimeWindow) ([]domain.ContributionEvent, error) {
+	var events []domain.ContributionEvent
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	for page := 0; page < maxTriagePages; page++ {
+		var q triageIssuesQuery
+		if err := s.client.Query(ctx, &q, variables); err != nil {
+			return nil, fmt.Errorf("listing issue timelines for %s: %w", repo, err)
+		}
+		if page == 0 && !canTriage(q.Repository.ViewerPermission) {
+			return nil, nil
+		}
+		ended := false
+		for _, item := range q.Repository.Issues.Nodes {
+			if window.Ended(item.UpdatedAt.Time) {
+				ended = true
+				break
+			}
+			events = append(events, triageEvents(item, repo, username, window)...)
+		}
+		if ended || !q.Repository.Issues.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(q.Repository.Issues.PageInfo.EndCursor)
+	}
+
+	variables["cursor"] = (*githubv4.String)(nil)
+	for page := 0; page < maxTriagePages; page++ {
+		var q triagePullRequestsQuery
+		if err := s.client.Query(ctx, &q, variables); err != nil {
+			return nil, fmt.Errorf("listing pull request timelines for %s: %w", repo, err)
+		}
+		ended := false
+		for _, item := range q.Repository.PullRequests.Nodes {
+			if window.Ended(item.UpdatedAt.Time) {
+				ended = true
+				break
+			}
+			events = append(events, triageEvents(item, repo, username, window)...)
+		}
+		if ended || !q.Repository.PullRequests.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(q.Repository.PullRequests.PageInfo.EndCursor)
+	}
+
+	return events, nil
+}
+
+// triageEvents extracts the user's actions from an item's timeline. Closing
+// your own issue or requesting reviews on your own PR needs no triage rights,
+// so those are skipped, as are actions outside window.
+func triageEvents(item triageItem, repo, username string, window domain.TimeWindow) []domain.ContributionEvent {
+	ownItem := strings.EqualFold(item.Author.Login, username)
+
+	var events []domain.ContributionEvent
+	for _, node := range item.TimelineItems.Nodes {
+		var fields triageEventFields
+		var action domain.TriageAction
+		switch node.Typename {
+		case "LabeledEvent":
+			fields, action = node.LabeledEvent, domain.TriageLabeled
+		case "ClosedEvent":
+			fields, action = node.ClosedEvent, domain.TriageClosed
+		case "MarkedAsDuplicateEvent":
+			fields, action = node.MarkedAsDuplicateEvent, domain.TriageMarkedAsDuplicate
+		case "ReviewRequestedEvent":
+			fields, action = node.ReviewRequestedEvent, domain.TriageReviewRequested
+		default:
+			continue
+		}
+		if !strings.EqualFold(fields.Actor.Login, username) || !window.Contains(fields.CreatedAt.Time) {
+			continue
+		}
+		if ownItem && (action == domain.TriageClosed || action == domain.TriageReviewRequested) {
+			continue
+		}
+
+		events = append(events, domain.ContributionEvent{
+			ID:           fields.ID,
+			Type:         domain.ContributionTypeTriage,
+			Repo:         repo,
+			URL:          item.URL,
+			Title:        item.Title,
+			CreatedAt:    fields.CreatedAt.Time,
+			TriageAction: action,
+		})
+	}
+	return events
+}
+
+func canTriage(permission githubv4.RepositoryPermission) bool {
+	switch permission {
+	case githubv4.RepositoryPermissionTriage, githubv4.RepositoryPermissionWrite,
+		githubv4.RepositoryPermissionMaintain, githubv4.RepositoryPermissionAdmin:
+		return true
+	default:
+		return false
+	}
+}
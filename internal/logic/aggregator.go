@@ -4,16 +4,22 @@ import (
 	"github.com/arayofcode/footprint/internal/domain"
 )
 
-const RepoMultiplierCap = 4.0
-
 // Aggregate reduces semantic events and enriched projects into finalized impact summaries.
 // Logic:
-// 1. External Impact: Sum BaseScore per repo, take Max(PopularityRaw), apply cap, multiply.
+// 1. External Impact: Sum BaseScore per repo, take Max(PopularityRaw), apply config.MultiplierCap(), multiply.
 // 2. Owned Projects: Use BaseScore, apply cap to PopularityRaw, multiply.
 // 3. Stats: Sum raw activity counts (unweighted).
-func Aggregate(events []domain.SemanticEvent, projects []domain.EnrichedProject) (domain.StatsView, []domain.RepoContribution, []domain.OwnedProjectImpact) {
+//
+// Releases are the exception to (1): they carry their own dampened popularity,
+// are scaled per event, and are added to the repo score after the multiplier.
+//
+// With config.RecencyHalfLifeDays set, each event in (1) counts with its
+// RecencyFactor; LifetimeScore repeats the sum without that weighting.
+func Aggregate(events []domain.SemanticEvent, projects []domain.EnrichedProject, config domain.ScoringConfig) (domain.StatsView, []domain.RepoContribution, []domain.OwnedProjectImpact) {
 	var stats domain.StatsView
 	repoMap := make(map[string]*domain.RepoContribution)
+	lifetimeBase := make(map[string]float64)
+	lifetimeReleases := make(map[string]float64)
 
 	// Track all unique repos for stats
 	allRepos := make(map[string]bool)
@@ -45,6 +51,12 @@ func Aggregate(events []domain.SemanticEvent, projects []domain.EnrichedProject)
 			stats.DiscussionComments++
 		case domain.SemanticEventCommit:
 			stats.TotalCommits++
+		case domain.SemanticEventSecurityAdvisory:
+			stats.SecurityAdvisories++
+		case domain.SemanticEventReleasePublished:
+			stats.ReleasesPublished++
+		case domain.SemanticEventTriage:
+			stats.TriageActions++
 		}
 
 		// Skip owned projects for the external contributions breakdown
@@ -61,13 +73,29 @@ func Aggregate(events []domain.SemanticEvent, projects []domain.EnrichedProject)
 		}
 
 		contrib := repoMap[e.Repo]
-		contrib.BaseScore += e.BaseScore
-		if e.PopularityRaw > contrib.PopularityRaw {
-			contrib.PopularityRaw = e.PopularityRaw
+		// Events from older dumps may lack the language; any event can supply it
+		if contrib.Language == "" {
+			contrib.Language, contrib.LanguageColor = e.Language, e.LanguageColor
+		}
+		weight := recencyWeight(e, config)
+		if e.Type == domain.SemanticEventReleasePublished {
+			score := e.BaseScore * cappedMultiplier(e.PopularityRaw, config.MultiplierCap())
+			contrib.ReleaseScore += score * weight
+			lifetimeReleases[e.Repo] += score
+		} else {
+			contrib.BaseScore += e.BaseScore * weight
+			lifetimeBase[e.Repo] += e.BaseScore
+			if e.PopularityRaw > contrib.PopularityRaw {
+				contrib.PopularityRaw = e.PopularityRaw
+			}
 		}
 
 		// Contribution projection moved to separate adapter
 
+		if len(e.Trivial) > 0 {
+			contrib.TrivialPRs++
+		}
+
 		switch e.Type {
 		case domain.SemanticEventPrOpened:
 			contrib.PRsOpened++
@@ -85,6 +113,12 @@ func Aggregate(events []domain.SemanticEvent, projects []domain.EnrichedProject)
 			contrib.DiscussionComments++
 		case domain.SemanticEventCommit:
 			contrib.Commits++
+		case domain.SemanticEventSecurityAdvisory:
+			contrib.SecurityAdvisories++
+		case domain.SemanticEventReleasePublished:
+			contrib.Releases++
+		case domain.SemanticEventTriage:
+			contrib.TriageActions++
 		}
 	}
 	stats.TotalReposContributedTo = len(allRepos)
@@ -92,14 +126,9 @@ func Aggregate(events []domain.SemanticEvent, projects []domain.EnrichedProject)
 	var contributions []domain.RepoContribution
 	for _, c := range repoMap {
 		// Apply capped popularity multiplier at repo level
-		multiplier := c.PopularityRaw
-		if multiplier > RepoMultiplierCap {
-			multiplier = RepoMultiplierCap
-		}
-		if multiplier < 1.0 {
-			multiplier = 1.0
-		}
-		c.Score = c.BaseScore * multiplier // Final weighted score
+		multiplier := cappedMultiplier(c.PopularityRaw, config.MultiplierCap())
+		c.Score = c.BaseScore*multiplier + c.ReleaseScore // Final weighted score
+		c.LifetimeScore = lifetimeBase[c.Repo]*multiplier + lifetimeReleases[c.Repo]
 
 		contributions = append(contributions, *c)
 	}
@@ -110,18 +139,14 @@ func Aggregate(events []domain.SemanticEvent, projects []domain.EnrichedProject)
 		stats.ProjectsOwned++
 		stats.StarsEarned += p.Stars
 
-		multiplier := p.PopularityRaw
-		if multiplier > RepoMultiplierCap {
-			multiplier = RepoMultiplierCap
-		}
-		if multiplier < 1.0 {
-			multiplier = 1.0
-		}
+		multiplier := cappedMultiplier(p.PopularityRaw, config.MultiplierCap())
 
 		projectImpacts = append(projectImpacts, domain.OwnedProjectImpact{
 			Repo:          p.Repo,
 			URL:           p.URL,
 			AvatarURL:     p.AvatarURL,
+			Language:      p.Language,
+			LanguageColor: p.LanguageColor,
 			Stars:         p.Stars,
 			Forks:         p.Forks,
 			BaseScore:     p.BaseScore,
@@ -132,3 +157,50 @@ func Aggregate(events []domain.SemanticEvent, projects []domain.EnrichedProject)
 
 	return stats, contributions, projectImpacts
 }
+
+// recencyWeight is the factor an event's score counts with: its recency
+// factor when recency weighting is enabled, otherwise 1.
+func recencyWeight(e domain.SemanticEvent, config domain.ScoringConfig) float64 {
+	if config.RecencyHalfLifeDays > 0 {
+		return e.RecencyFactor
+	}
+	return 1
+}
+
+// cappedMultiplier clamps a raw popularity multiplier to [1, limit].
+func cappedMultiplier(raw, limit float64) float64 {
+	return min(max(raw, 1.0), limit)
+}
+
+// AttachBreakdowns completes each event's score breakdown with the popularity
+// multiplier and final share of the repo score that Aggregate applied. Events
+// in owned repos, which Aggregate leaves out of repo scores, keep a zero
+// Score. The input events are not modified.
+func AttachBreakdowns(events []domain.SemanticEvent, repos []domain.RepoContribution, config domain.ScoringConfig) []domain.SemanticEvent {
+	peak := make(map[string]float64, len(repos))
+	for _, r := range repos {
+		peak[r.Repo] = r.PopularityRaw
+	}
+
+	attached := make([]domain.SemanticEvent, len(events))
+	for i, e := range events {
+		attached[i] = e
+		if e.Breakdown == nil {
+			continue
+		}
+		breakdown := *e.Breakdown
+		attached[i].Breakdown = &breakdown
+
+		raw, ok := peak[e.Repo]
+		if !ok {
+			continue
+		}
+		if e.Type == domain.SemanticEventReleasePublished {
+			raw = e.PopularityRaw
+		}
+		breakdown.Multiplier = cappedMultiplier(raw, config.MultiplierCap())
+		breakdown.Capped = raw > config.MultiplierCap()
+		breakdown.Score = e.BaseScore * recencyWeight(e, config) * breakdown.Multiplier
+	}
+	return attached
+}
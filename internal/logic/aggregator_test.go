@@ -6,6 +6,8 @@ import (
 	"github.com/arayofcode/footprint/internal/domain"
 )
 
+var testConfig = domain.ScoringConfig{RepoMultiplierCap: 4.0}
+
 func TestAggregate(t *testing.T) {
 	projects := []domain.EnrichedProject{
 		{
@@ -22,7 +24,7 @@ func TestAggregate(t *testing.T) {
 		{Type: domain.SemanticEventIssueComment, Repo: "me/owned", BaseScore: 2, PopularityRaw: 1.0}, // Should be excluded from contributions
 	}
 
-	stats, contribs, impacts := Aggregate(events, projects)
+	stats, contribs, impacts := Aggregate(events, projects, testConfig)
 
 	if len(impacts) != 1 {
 		t.Errorf("expected 1 project impact, got %d", len(impacts))
@@ -97,7 +99,7 @@ func TestAggregate_DoesNotMultiplyPerEvent(t *testing.T) {
 		{Repo: "a", BaseScore: 10, PopularityRaw: 5.0},
 	}
 
-	_, contribs, _ := Aggregate(events, nil)
+	_, contribs, _ := Aggregate(events, nil, testConfig)
 	c := contribs[0]
 	// Correct: (10 + 10) * 4.0 = 80
 	if c.Score != 80 {
@@ -112,7 +114,7 @@ func TestAggregate_MaxPopularityChosen(t *testing.T) {
 		{Repo: "a", BaseScore: 10, PopularityRaw: 2.5},
 	}
 
-	_, contribs, _ := Aggregate(events, nil)
+	_, contribs, _ := Aggregate(events, nil, testConfig)
 	c := contribs[0]
 	// Max popularity = 3.9
 	// sum(BaseScore) = 30
@@ -128,7 +130,7 @@ func TestAggregate_RepoLevelMultiplierCapping(t *testing.T) {
 		{Type: domain.SemanticEventPrReview, Repo: "popular/repo", BaseScore: 5, PopularityRaw: 5.0},
 	}
 
-	_, contribs, _ := Aggregate(events, nil)
+	_, contribs, _ := Aggregate(events, nil, testConfig)
 	c := contribs[0]
 	// BaseScore = 10 + 5 = 15
 	// MaxPopularity = 5.0 -> capped to 4.0
@@ -138,3 +140,159 @@ func TestAggregate_RepoLevelMultiplierCapping(t *testing.T) {
 		t.Errorf("expected capped score %v, got %v (BaseScore: %v, PopularityRaw: %v)", expected, c.Score, c.BaseScore, c.PopularityRaw)
 	}
 }
+
+func TestAggregate_CountsSecurityAdvisories(t *testing.T) {
+	events := []domain.SemanticEvent{
+		{Type: domain.SemanticEventSecurityAdvisory, Repo: "ext/repo", BaseScore: 25, PopularityRaw: 1.0},
+		{Type: domain.SemanticEventSecurityAdvisory, Repo: "ext/repo", BaseScore: 8, PopularityRaw: 1.0},
+	}
+
+	stats, contribs, _ := Aggregate(events, nil, testConfig)
+
+	if stats.TriageActions != 0 {
+		t.Errorf("expected no triage actions, got %d", stats.TriageActions)
+	}
+	if stats.SecurityAdvisories != 2 {
+		t.Errorf("expected 2 security advisories, got %d", stats.SecurityAdvisories)
+	}
+	if len(contribs) != 1 || contribs[0].SecurityAdvisories != 2 {
+		t.Fatalf("expected ext/repo to record 2 advisories, got %+v", contribs)
+	}
+	if contribs[0].Score != 33 {
+		t.Errorf("expected advisory base scores to be summed, got %f", contribs[0].Score)
+	}
+}
+
+func TestAggregate_ReleasesUseTheirOwnPopularity(t *testing.T) {
+	events := []domain.SemanticEvent{
+		{Type: domain.SemanticEventPrOpened, Repo: "ext/repo", BaseScore: 10, PopularityRaw: 3.0},
+		{Type: domain.SemanticEventReleasePublished, Repo: "ext/repo", BaseScore: 8, PopularityRaw: 2.0},
+	}
+
+	stats, contribs, _ := Aggregate(events, nil, testConfig)
+
+	if stats.ReleasesPublished != 1 {
+		t.Errorf("expected 1 release published, got %d", stats.ReleasesPublished)
+	}
+	if len(contribs) != 1 {
+		t.Fatalf("expected 1 repo contribution, got %d", len(contribs))
+	}
+	c := contribs[0]
+	if c.Releases != 1 {
+		t.Errorf("expected 1 release, got %d", c.Releases)
+	}
+	if c.BaseScore != 10 || c.PopularityRaw != 3.0 {
+		t.Errorf("expected releases to stay out of the repo base score and multiplier, got base %f pop %f", c.BaseScore, c.PopularityRaw)
+	}
+	// PR: 10 * 3.0, release: 8 * 2.0
+	if c.Score != 46 {
+		t.Errorf("expected score 46, got %f", c.Score)
+	}
+}
+
+func TestAggregate_CountsTriageActions(t *testing.T) {
+	events := []domain.SemanticEvent{
+		{Type: domain.SemanticEventTriage, Repo: "ext/repo", BaseScore: 0.5, TriageAction: domain.TriageLabeled},
+		{Type: domain.SemanticEventTriage, Repo: "ext/repo", BaseScore: 0.1, TriageAction: domain.TriageClosed},
+	}
+
+	stats, contribs, _ := Aggregate(events, nil, testConfig)
+
+	if stats.TriageActions != 2 {
+		t.Errorf("expected 2 triage actions, got %d", stats.TriageActions)
+	}
+	if len(contribs) != 1 || contribs[0].TriageActions != 2 {
+		t.Fatalf("expected ext/repo to record 2 triage actions, got %+v", contribs)
+	}
+}
+
+func TestAggregate_CountsTrivialPRs(t *testing.T) {
+	events := []domain.SemanticEvent{
+		{Type: domain.SemanticEventPrOpened, Repo: "ext/repo", BaseScore: 2.5, Trivial: []domain.TrivialReason{domain.TrivialTitle}},
+		{Type: domain.SemanticEventPrOpened, Repo: "ext/repo", BaseScore: 10},
+	}
+
+	_, contribs, _ := Aggregate(events, nil, testConfig)
+	if len(contribs) != 1 || contribs[0].TrivialPRs != 1 || contribs[0].PRsOpened != 2 {
+		t.Fatalf("expected 1 of 2 PRs flagged trivial, got %+v", contribs)
+	}
+}
+
+func TestAggregate_CarriesLanguages(t *testing.T) {
+	projects := []domain.EnrichedProject{
+		{OwnedProject: domain.OwnedProject{Repo: "me/owned", Language: "Go", LanguageColor: "#00ADD8"}},
+	}
+	events := []domain.SemanticEvent{
+		{Type: domain.SemanticEventPrOpened, Repo: "ext/repo", BaseScore: 5}, // From a dump fetched before languages were
+		{Type: domain.SemanticEventPrOpened, Repo: "ext/repo", BaseScore: 5, Language: "Rust", LanguageColor: "#dea584"},
+	}
+
+	_, contribs, impacts := Aggregate(events, projects, testConfig)
+	if len(contribs) != 1 || contribs[0].Language != "Rust" || contribs[0].LanguageColor != "#dea584" {
+		t.Errorf("expected ext/repo to be Rust, got %+v", contribs)
+	}
+	if len(impacts) != 1 || impacts[0].Language != "Go" || impacts[0].LanguageColor != "#00ADD8" {
+		t.Errorf("expected me/owned to be Go, got %+v", impacts)
+	}
+}
+
+func TestAggregate_WeightsByRecencyWhenEnabled(t *testing.T) {
+	events := []domain.SemanticEvent{
+		{Type: domain.SemanticEventPrOpened, Repo: "ext/repo", BaseScore: 10, PopularityRaw: 2.0, RecencyFactor: 0.5},
+		{Type: domain.SemanticEventReleasePublished, Repo: "ext/repo", BaseScore: 8, PopularityRaw: 1.0, RecencyFactor: 0.25},
+	}
+
+	_, contribs, _ := Aggregate(events, nil, testConfig)
+	if contribs[0].Score != 28 || contribs[0].LifetimeScore != 28 {
+		t.Errorf("expected recency ignored when disabled, got score %f lifetime %f", contribs[0].Score, contribs[0].LifetimeScore)
+	}
+
+	config := testConfig
+	config.RecencyHalfLifeDays = 365
+	_, contribs, _ = Aggregate(events, nil, config)
+	// PR: 10 * 0.5 * 2.0, release: 8 * 1.0 * 0.25
+	if contribs[0].Score != 12 {
+		t.Errorf("expected recent score 12, got %f", contribs[0].Score)
+	}
+	if contribs[0].LifetimeScore != 28 {
+		t.Errorf("expected lifetime score 28, got %f", contribs[0].LifetimeScore)
+	}
+}
+
+func TestAttachBreakdowns_RecordsShareOfRepoScore(t *testing.T) {
+	events := []domain.SemanticEvent{
+		{Type: domain.SemanticEventPrOpened, Repo: "ext/repo", BaseScore: 10, PopularityRaw: 5.0, Breakdown: &domain.ScoreBreakdown{Base: 10, Popularity: 5.0}},
+		{Type: domain.SemanticEventIssueComment, Repo: "ext/repo", BaseScore: 2, PopularityRaw: 1.5, Breakdown: &domain.ScoreBreakdown{Base: 2, Popularity: 1.5}},
+		{Type: domain.SemanticEventReleasePublished, Repo: "ext/repo", BaseScore: 8, PopularityRaw: 2.0, Breakdown: &domain.ScoreBreakdown{Base: 8, Popularity: 2.0}},
+		{Type: domain.SemanticEventPrOpened, Repo: "me/owned", BaseScore: 10, PopularityRaw: 2.0, Breakdown: &domain.ScoreBreakdown{Base: 10, Popularity: 2.0}},
+	}
+	projects := []domain.EnrichedProject{{OwnedProject: domain.OwnedProject{Repo: "me/owned"}}}
+
+	_, contribs, _ := Aggregate(events, projects, testConfig)
+	attached := AttachBreakdowns(events, contribs, testConfig)
+
+	// Repo peak popularity 5.0 is capped at 4.0 for every non-release event
+	pr := attached[0].Breakdown
+	if pr.Multiplier != 4 || !pr.Capped || pr.Score != 40 {
+		t.Errorf("expected PR multiplier 4 (capped) and score 40, got %+v", pr)
+	}
+	comment := attached[1].Breakdown
+	if comment.Multiplier != 4 || comment.Score != 8 {
+		t.Errorf("expected comment to share the repo multiplier, got %+v", comment)
+	}
+	release := attached[2].Breakdown
+	if release.Multiplier != 2 || release.Capped || release.Score != 16 {
+		t.Errorf("expected release scaled by its own popularity, got %+v", release)
+	}
+	if attached[3].Breakdown.Score != 0 {
+		t.Errorf("expected owned repo events to add nothing, got %+v", attached[3].Breakdown)
+	}
+
+	sum := pr.Score + comment.Score + release.Score
+	if sum != contribs[0].Score {
+		t.Errorf("expected breakdown scores to sum to the repo score %f, got %f", contribs[0].Score, sum)
+	}
+	if events[0].Breakdown.Score != 0 {
+		t.Errorf("expected input events to be left unmodified")
+	}
+}
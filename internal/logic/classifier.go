@@ -24,6 +24,12 @@ func Classify(e domain.ContributionEvent) domain.SemanticEvent {
 		} else {
 			semanticType = domain.SemanticEventIssueComment
 		}
+	case domain.ContributionTypeSecurityAdvisory:
+		semanticType = domain.SemanticEventSecurityAdvisory
+	case domain.ContributionTypeRelease:
+		semanticType = domain.SemanticEventReleasePublished
+	case domain.ContributionTypeTriage:
+		semanticType = domain.SemanticEventTriage
 	case domain.ContributionTypeDiscussion, domain.ContributionTypeDiscussionComment:
 		// Map discussions to issue comments for now, or new type later
 		semanticType = domain.SemanticEventIssueComment
@@ -34,16 +40,34 @@ func Classify(e domain.ContributionEvent) domain.SemanticEvent {
 		Type:           semanticType,
 		Repo:           e.Repo,
 		AvatarURL:      e.RepoOwnerAvatarURL,
+		Language:       e.Language,
+		LanguageColor:  e.LanguageColor,
 		URL:            e.URL,
 		Title:          e.Title,
 		CreatedAt:      e.CreatedAt,
 		BaseScore:      e.BaseScore,
 		PopularityRaw:  e.PopularityRaw,
+		RecencyFactor:  e.RecencyFactor,
+		Breakdown:      e.Breakdown,
+		Trivial:        e.Trivial,
 		Merged:         e.Merged,
+		MergedAt:       e.MergedAt,
 		ReactionsCount: e.ReactionsCount,
+		Reactions:      e.Reactions,
+		ResolvedIssues: e.ResolvedIssues,
+		Advisory:       e.Advisory,
+		TriageAction:   e.TriageAction,
+		Stars:          e.Stars,
+		FirstTimer:     isFirstTimer(e.AuthorAssociation),
 	}
 }
 
+// isFirstTimer reports whether GitHub recorded the author as new to the repo
+// (FIRST_TIME_CONTRIBUTOR) or new to GitHub altogether (FIRST_TIMER).
+func isFirstTimer(association string) bool {
+	return association == "FIRST_TIME_CONTRIBUTOR" || association == "FIRST_TIMER"
+}
+
 func MapClassify(events []domain.ContributionEvent) []domain.SemanticEvent {
 	semantic := make([]domain.SemanticEvent, len(events))
 	for i, e := range events {
@@ -37,6 +37,21 @@ func TestClassify(t *testing.T) {
 			input:    domain.ContributionEvent{Type: domain.ContributionTypeIssueComment, URL: "https://github.com/a/b/pull/1#comment-1"},
 			expected: domain.SemanticEventPrReviewComment,
 		},
+		{
+			name:     "Security Advisory Credit",
+			input:    domain.ContributionEvent{Type: domain.ContributionTypeSecurityAdvisory, Advisory: &domain.AdvisoryCredit{GHSAID: "GHSA-xxxx"}},
+			expected: domain.SemanticEventSecurityAdvisory,
+		},
+		{
+			name:     "Release Published",
+			input:    domain.ContributionEvent{Type: domain.ContributionTypeRelease},
+			expected: domain.SemanticEventReleasePublished,
+		},
+		{
+			name:     "Triage Action",
+			input:    domain.ContributionEvent{Type: domain.ContributionTypeTriage, TriageAction: domain.TriageLabeled},
+			expected: domain.SemanticEventTriage,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,195 @@
+package logic
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// AggregateCommunity builds a community report for a maintainer's own repos.
+// Contributors are scored with the same model as a personal footprint, each on
+// their own, and exclude scope.Owner. Review load and bus factor describe
+// everyone who did the work, the owner included.
+//
+// First-timers are the contributors GitHub flagged as new on a PR or issue
+// (see isFirstTimer), counted in the month of the earliest such item. The
+// earliest fetched event can't tell: older work may lie beyond the pages
+// fetched or before the window.
+func AggregateCommunity(scope domain.CommunityScope, contributors []domain.MemberActivity, pulls []domain.PullRequestActivity, config domain.ScoringConfig) domain.CommunityFootprint {
+	footprint := domain.CommunityFootprint{Scope: scope}
+	isOwner := func(username string) bool {
+		return strings.EqualFold(username, scope.Owner)
+	}
+
+	var external []domain.SemanticEvent
+	firstTimers := make(map[string]int)
+	for _, c := range contributors {
+		if isOwner(c.User.Username) || len(c.Events) == 0 {
+			continue
+		}
+		external = append(external, c.Events...)
+
+		stats, repos, _ := Aggregate(c.Events, nil, config)
+		sortRepoContributions(repos)
+		score := 0.0
+		for _, r := range repos {
+			score += r.Score
+		}
+
+		first := c.Events[0].CreatedAt
+		var joined time.Time
+		for _, e := range c.Events {
+			if e.CreatedAt.Before(first) {
+				first = e.CreatedAt
+			}
+			if e.FirstTimer && (joined.IsZero() || e.CreatedAt.Before(joined)) {
+				joined = e.CreatedAt
+			}
+		}
+		if !joined.IsZero() {
+			firstTimers[joined.Format("2006-01")]++
+		}
+
+		footprint.Contributors = append(footprint.Contributors, domain.CommunityContributor{
+			MemberFootprint: domain.MemberFootprint{
+				User:  c.User,
+				Stats: stats,
+				Score: score,
+				Repos: repos,
+			},
+			FirstContribution: first,
+		})
+	}
+	sort.SliceStable(footprint.Contributors, func(i, j int) bool {
+		if footprint.Contributors[i].Score != footprint.Contributors[j].Score {
+			return footprint.Contributors[i].Score > footprint.Contributors[j].Score
+		}
+		return footprint.Contributors[i].User.Username < footprint.Contributors[j].User.Username
+	})
+
+	stats, repos, _ := Aggregate(external, nil, config)
+	sortRepoContributions(repos)
+	footprint.Stats = stats
+	footprint.Repos = repos
+	footprint.Events = len(external)
+	for _, r := range repos {
+		footprint.TotalScore += r.Score
+		footprint.LifetimeScore += r.LifetimeScore
+	}
+
+	for period, count := range firstTimers {
+		footprint.FirstTimers = append(footprint.FirstTimers, domain.PeriodCount{Period: period, Count: count})
+	}
+	sort.Slice(footprint.FirstTimers, func(i, j int) bool {
+		return footprint.FirstTimers[i].Period < footprint.FirstTimers[j].Period
+	})
+
+	footprint.Reviews = reviewLoad(pulls, isOwner)
+	footprint.BusFactor = busFactor(pulls, footprint.Reviews)
+
+	return footprint
+}
+
+func reviewLoad(pulls []domain.PullRequestActivity, isOwner func(string) bool) domain.ReviewLoad {
+	load := domain.ReviewLoad{PullRequests: len(pulls)}
+	counts := make(map[string]*domain.ReviewerLoad)
+	var waits []time.Duration
+
+	for _, pr := range pulls {
+		if len(pr.Reviews) == 0 {
+			continue
+		}
+		load.Reviewed++
+
+		first := pr.Reviews[0].CreatedAt
+		for _, r := range pr.Reviews {
+			if r.CreatedAt.Before(first) {
+				first = r.CreatedAt
+			}
+
+			load.Reviews++
+			key := strings.ToLower(r.Reviewer.Username)
+			if counts[key] == nil {
+				counts[key] = &domain.ReviewerLoad{User: r.Reviewer}
+			}
+			counts[key].Reviews++
+			if isOwner(r.Reviewer.Username) {
+				load.MaintainerReviews++
+			}
+		}
+		waits = append(waits, max(first.Sub(pr.CreatedAt), 0))
+	}
+
+	for _, c := range counts {
+		c.Share = float64(c.Reviews) / float64(load.Reviews)
+		load.Reviewers = append(load.Reviewers, *c)
+		if !isOwner(c.User.Username) {
+			load.CommunityReviewers++
+		}
+	}
+	sort.Slice(load.Reviewers, func(i, j int) bool {
+		if load.Reviewers[i].Reviews != load.Reviewers[j].Reviews {
+			return load.Reviewers[i].Reviews > load.Reviewers[j].Reviews
+		}
+		return load.Reviewers[i].User.Username < load.Reviewers[j].User.Username
+	})
+
+	if len(waits) > 0 {
+		sort.Slice(waits, func(i, j int) bool { return waits[i] < waits[j] })
+		mid := len(waits) / 2
+		load.MedianFirstReview = waits[mid]
+		if len(waits)%2 == 0 {
+			load.MedianFirstReview = (waits[mid-1] + waits[mid]) / 2
+		}
+	}
+
+	return load
+}
+
+func busFactor(pulls []domain.PullRequestActivity, reviews domain.ReviewLoad) domain.BusFactor {
+	authors := make(map[string]int)
+	for _, pr := range pulls {
+		if pr.Merged {
+			authors[strings.ToLower(pr.Author)]++
+		}
+	}
+	authorCounts := make([]int, 0, len(authors))
+	for _, n := range authors {
+		authorCounts = append(authorCounts, n)
+	}
+
+	reviewerCounts := make([]int, 0, len(reviews.Reviewers))
+	for _, r := range reviews.Reviewers {
+		reviewerCounts = append(reviewerCounts, r.Reviews)
+	}
+
+	var bf domain.BusFactor
+	bf.Authors, bf.TopAuthorShare = halfOfWork(authorCounts)
+	bf.Reviewers, bf.TopReviewerShare = halfOfWork(reviewerCounts)
+	return bf
+}
+
+// halfOfWork returns the fewest people whose counts reach half the total,
+// and the share of the largest count.
+func halfOfWork(counts []int) (int, float64) {
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	if total == 0 {
+		return 0, 0
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(counts)))
+	people, sum := 0, 0
+	for _, n := range counts {
+		people++
+		sum += n
+		if 2*sum >= total {
+			break
+		}
+	}
+	return people, float64(counts[0]) / float64(total)
+}
@@ -0,0 +1,114 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestAggregateCommunity(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+	user := func(name string) domain.User { return domain.User{Username: name} }
+
+	scope := domain.CommunityScope{Owner: "ray", Repos: []string{"ray/tool"}}
+	contributors := []domain.MemberActivity{
+		{User: user("ana"), Events: []domain.SemanticEvent{
+			{ID: "pr1", Type: domain.SemanticEventPrOpened, Repo: "ray/tool", BaseScore: 10, PopularityRaw: 1, CreatedAt: day(5)},
+			{ID: "pr2", Type: domain.SemanticEventPrOpened, Repo: "ray/tool", BaseScore: 10, PopularityRaw: 1, CreatedAt: day(2), FirstTimer: true},
+		}},
+		{User: user("bo"), Events: []domain.SemanticEvent{
+			{ID: "i1", Type: domain.SemanticEventIssueOpened, Repo: "ray/tool", BaseScore: 5, PopularityRaw: 1, CreatedAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), FirstTimer: true},
+		}},
+		{User: user("Ray"), Events: []domain.SemanticEvent{
+			{ID: "pr3", Type: domain.SemanticEventPrOpened, Repo: "ray/tool", BaseScore: 10, PopularityRaw: 1, CreatedAt: day(1)},
+		}},
+	}
+	pulls := []domain.PullRequestActivity{
+		{Author: "ana", Merged: true, CreatedAt: day(2), Reviews: []domain.PullRequestReview{{Reviewer: user("ray"), CreatedAt: day(3)}}},
+		{Author: "ana", Merged: true, CreatedAt: day(5), Reviews: []domain.PullRequestReview{{Reviewer: user("bo"), CreatedAt: day(9)}, {Reviewer: user("ray"), CreatedAt: day(8)}}},
+		{Author: "ray", Merged: true, CreatedAt: day(1)},
+		{Author: "bo", Merged: false, CreatedAt: day(1)},
+	}
+
+	community := AggregateCommunity(scope, contributors, pulls, testConfig)
+
+	if len(community.Contributors) != 2 || community.Contributors[0].User.Username != "ana" {
+		t.Fatalf("expected ana and bo ranked by score, got %+v", community.Contributors)
+	}
+	if !community.Contributors[0].FirstContribution.Equal(day(2)) {
+		t.Errorf("expected ana's first contribution on Jan 2, got %v", community.Contributors[0].FirstContribution)
+	}
+	if community.Stats.PRsOpened != 2 || community.TotalScore != 25 {
+		t.Errorf("expected owner excluded from stats and score, got %+v and %f", community.Stats, community.TotalScore)
+	}
+	if len(community.FirstTimers) != 2 || community.FirstTimers[0] != (domain.PeriodCount{Period: "2025-01", Count: 1}) {
+		t.Errorf("unexpected first-timers %+v", community.FirstTimers)
+	}
+
+	load := community.Reviews
+	if load.PullRequests != 4 || load.Reviewed != 2 || load.Reviews != 3 {
+		t.Errorf("unexpected review counts %+v", load)
+	}
+	if load.MaintainerReviews != 2 || load.CommunityReviewers != 1 {
+		t.Errorf("expected 2 maintainer reviews and 1 community reviewer, got %d and %d", load.MaintainerReviews, load.CommunityReviewers)
+	}
+	// First reviews after 1 and 3 days
+	if load.MedianFirstReview != 48*time.Hour {
+		t.Errorf("expected median first review of 2 days, got %v", load.MedianFirstReview)
+	}
+	if load.Reviewers[0].User.Username != "ray" || load.Reviewers[0].Reviews != 2 {
+		t.Errorf("expected ray to lead reviews, got %+v", load.Reviewers)
+	}
+
+	// ana wrote 2 of 3 merged PRs; ray did 2 of 3 reviews
+	bf := community.BusFactor
+	if bf.Authors != 1 || bf.Reviewers != 1 {
+		t.Errorf("expected bus factor 1/1, got %+v", bf)
+	}
+	if bf.TopAuthorShare < 0.66 || bf.TopAuthorShare > 0.67 {
+		t.Errorf("expected top author share of 2/3, got %f", bf.TopAuthorShare)
+	}
+}
+
+func TestAggregateCommunity_FirstTimersComeFromAuthorAssociation(t *testing.T) {
+	scope := domain.CommunityScope{Owner: "ray", Repos: []string{"ray/tool"}}
+	event := func(id, association string, month time.Month) domain.SemanticEvent {
+		return Classify(domain.ContributionEvent{
+			ID: id, Type: domain.ContributionTypePR, Repo: "ray/tool", AuthorAssociation: association,
+			CreatedAt: time.Date(2025, month, 1, 0, 0, 0, 0, time.UTC),
+		})
+	}
+	contributors := []domain.MemberActivity{
+		// A long-time contributor whose older work fell outside the fetched pages
+		{User: domain.User{Username: "veteran"}, Events: []domain.SemanticEvent{event("pr1", "CONTRIBUTOR", 2)}},
+		// A newcomer whose first PR is counted, not the follow-up
+		{User: domain.User{Username: "newbie"}, Events: []domain.SemanticEvent{
+			event("pr2", "CONTRIBUTOR", 5),
+			event("pr3", "FIRST_TIME_CONTRIBUTOR", 4),
+		}},
+	}
+
+	community := AggregateCommunity(scope, contributors, nil, testConfig)
+
+	if len(community.FirstTimers) != 1 || community.FirstTimers[0] != (domain.PeriodCount{Period: "2025-04", Count: 1}) {
+		t.Errorf("expected only newbie, in April, got %+v", community.FirstTimers)
+	}
+}
+
+func TestHalfOfWork(t *testing.T) {
+	tests := []struct {
+		counts []int
+		people int
+	}{
+		{nil, 0},
+		{[]int{1, 1, 1, 1}, 2},
+		{[]int{1, 8, 1}, 1},
+		{[]int{3, 3, 3, 3, 3}, 3},
+	}
+	for _, tt := range tests {
+		if got, _ := halfOfWork(tt.counts); got != tt.people {
+			t.Errorf("halfOfWork(%v) = %d, want %d", tt.counts, got, tt.people)
+		}
+	}
+}
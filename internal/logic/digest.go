@@ -0,0 +1,81 @@
+package logic
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// SnapshotBaseline is the baseline a history snapshot gives. Snapshots don't
+// record individual PRs, so MergedPRs stays unknown.
+func SnapshotBaseline(s domain.Snapshot) domain.Baseline {
+	return domain.Baseline{
+		TakenAt:      s.TakenAt,
+		Score:        s.Score,
+		Repos:        s.Repos,
+		ProjectStars: s.ProjectStars,
+	}
+}
+
+// ComputeDigest lists what changed since baseline. Without a baseline, on
+// the first run, it only carries the current score. When the baseline doesn't
+// know which PRs were merged, PRs merged after it are listed instead.
+func ComputeDigest(baseline *domain.Baseline, stats domain.StatsView, repos []domain.RepoContribution, projects []domain.OwnedProjectImpact) domain.Digest {
+	// Only the snapshot's scores are compared, so its time and hash are unset
+	current := TakeSnapshot(time.Time{}, "", stats, repos, projects)
+	digest := domain.Digest{Score: current.Score}
+	if baseline == nil {
+		return digest
+	}
+	digest.Since = baseline.TakenAt
+	digest.ScoreDelta = current.Score - baseline.Score
+
+	added, deltas := diffRepos(baseline.Repos, current.Repos)
+	digest.Repos = deltas
+	external := make(map[string]bool, len(repos))
+	for _, r := range repos {
+		external[r.Repo] = true
+	}
+	for _, repo := range added {
+		if external[repo] {
+			digest.NewRepos = append(digest.NewRepos, repo)
+		}
+	}
+
+	for _, r := range repos {
+		for _, e := range r.Events {
+			if e.Type != domain.ContributionPR || !e.Merged {
+				continue
+			}
+			if baseline.MergedPRs != nil && baseline.MergedPRs[e.URL] {
+				continue
+			}
+			merged := e.MergedAt
+			if merged.IsZero() {
+				// Reports written before merge times were kept
+				merged = e.CreatedAt
+			}
+			if baseline.MergedPRs == nil && !merged.After(baseline.TakenAt) {
+				continue
+			}
+			digest.MergedPRs = append(digest.MergedPRs, domain.DigestPR{Repo: e.Repo, Title: e.Title, URL: e.URL, CreatedAt: e.CreatedAt, MergedAt: merged})
+		}
+	}
+	slices.SortFunc(digest.MergedPRs, func(a, b domain.DigestPR) int {
+		return cmp.Or(b.MergedAt.Compare(a.MergedAt), cmp.Compare(a.URL, b.URL))
+	})
+
+	if baseline.ProjectStars != nil {
+		for _, p := range projects {
+			if gained := p.Stars - baseline.ProjectStars[p.Repo]; gained > 0 {
+				digest.NewStars = append(digest.NewStars, domain.StarGain{Repo: p.Repo, URL: p.URL, Stars: p.Stars, Gained: gained})
+			}
+		}
+		slices.SortFunc(digest.NewStars, func(a, b domain.StarGain) int {
+			return cmp.Or(cmp.Compare(b.Gained, a.Gained), cmp.Compare(a.Repo, b.Repo))
+		})
+	}
+	return digest
+}
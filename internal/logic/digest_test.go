@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestComputeDigest_FirstRun(t *testing.T) {
+	repos := []domain.RepoContribution{{Repo: "a/b", Score: 12}}
+
+	digest := ComputeDigest(nil, domain.StatsView{}, repos, nil)
+
+	if !digest.Since.IsZero() || digest.Score != 12 || !digest.IsEmpty() {
+		t.Errorf("expected only the current score on the first run, got %+v", digest)
+	}
+}
+
+func TestComputeDigest_AgainstReport(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	baseline := &domain.Baseline{
+		TakenAt:      since,
+		Score:        40,
+		Repos:        map[string]float64{"a/b": 30, "me/tool": 10},
+		ProjectStars: map[string]int{"me/tool": 8},
+		MergedPRs:    map[string]bool{"pr/1": true},
+	}
+	repos := []domain.RepoContribution{
+		{Repo: "a/b", Score: 36, Events: []domain.Contribution{
+			{Type: domain.ContributionPR, Repo: "a/b", URL: "pr/1", Merged: true, CreatedAt: since.AddDate(0, -1, 0)},
+			// Opened before the baseline but merged since
+			{Type: domain.ContributionPR, Repo: "a/b", URL: "pr/2", Title: "Old PR", Merged: true, CreatedAt: since.AddDate(0, -1, 0)},
+			{Type: domain.ContributionPR, Repo: "a/b", URL: "pr/3", CreatedAt: since.AddDate(0, 0, 1)},
+		}},
+		{Repo: "new/repo", Score: 4, Events: []domain.Contribution{
+			{Type: domain.ContributionPR, Repo: "new/repo", URL: "pr/4", Merged: true, CreatedAt: since.AddDate(0, 0, 2)},
+		}},
+	}
+	projects := []domain.OwnedProjectImpact{
+		{Repo: "me/tool", URL: "https://github.com/me/tool", Stars: 13, Score: 10},
+		{Repo: "me/fresh", Stars: 2, Score: 1},
+	}
+
+	digest := ComputeDigest(baseline, domain.StatsView{}, repos, projects)
+
+	if digest.Score != 51 || digest.ScoreDelta != 11 || !digest.Since.Equal(since) {
+		t.Errorf("expected score 51 (+11) since the baseline, got %+v", digest)
+	}
+	if len(digest.MergedPRs) != 2 || digest.MergedPRs[0].URL != "pr/4" || digest.MergedPRs[1].URL != "pr/2" {
+		t.Errorf("expected PRs merged since the report, newest first, got %+v", digest.MergedPRs)
+	}
+	if len(digest.NewRepos) != 1 || digest.NewRepos[0] != "new/repo" {
+		t.Errorf("expected only the external repo as new, got %v", digest.NewRepos)
+	}
+	wantStars := []domain.StarGain{
+		{Repo: "me/tool", URL: "https://github.com/me/tool", Stars: 13, Gained: 5},
+		{Repo: "me/fresh", Stars: 2, Gained: 2},
+	}
+	if len(digest.NewStars) != 2 || digest.NewStars[0] != wantStars[0] || digest.NewStars[1] != wantStars[1] {
+		t.Errorf("expected star gains %+v, got %+v", wantStars, digest.NewStars)
+	}
+	if len(digest.Repos) != 1 || digest.Repos[0] != (domain.RepoDelta{Repo: "a/b", Score: 6}) {
+		t.Errorf("expected a/b +6, got %+v", digest.Repos)
+	}
+}
+
+func TestComputeDigest_AgainstSnapshot(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	baseline := SnapshotBaseline(domain.Snapshot{TakenAt: since, Repos: map[string]float64{"a/b": 5}})
+	repos := []domain.RepoContribution{{Repo: "a/b", Score: 5, Events: []domain.Contribution{
+		{Type: domain.ContributionPR, URL: "old", Merged: true, CreatedAt: since.Add(-2 * time.Hour), MergedAt: since.Add(-time.Hour)},
+		// Opened before the snapshot, merged after it
+		{Type: domain.ContributionPR, URL: "late", Merged: true, CreatedAt: since.AddDate(0, -1, 0), MergedAt: since.Add(2 * time.Hour)},
+		{Type: domain.ContributionPR, URL: "new", Merged: true, CreatedAt: since.Add(time.Hour), MergedAt: since.Add(time.Hour)},
+	}}}
+	projects := []domain.OwnedProjectImpact{{Repo: "me/tool", Stars: 3}}
+
+	digest := ComputeDigest(&baseline, domain.StatsView{}, repos, projects)
+
+	if len(digest.MergedPRs) != 2 || digest.MergedPRs[0].URL != "late" || digest.MergedPRs[1].URL != "new" {
+		t.Errorf("expected PRs merged after the snapshot, latest merge first, got %+v", digest.MergedPRs)
+	}
+	if len(digest.NewStars) != 0 {
+		t.Errorf("expected no star gains without recorded project stars, got %+v", digest.NewStars)
+	}
+}
@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// ShareByLanguage splits the weighted score of external repos and owned
+// projects by each repo's primary language, largest share first. Repos with
+// no known language, e.g. docs-only ones, are left out, as in GitHub's own
+// language stats, so the shares add up to 1.
+func ShareByLanguage(repos []domain.RepoContribution, projects []domain.OwnedProjectImpact) []domain.LanguageShare {
+	byLanguage := make(map[string]*domain.LanguageShare)
+	var total float64
+	add := func(language, color string, score float64) {
+		if language == "" || score <= 0 {
+			return
+		}
+		s, ok := byLanguage[language]
+		if !ok {
+			s = &domain.LanguageShare{Language: language, Color: color}
+			byLanguage[language] = s
+		}
+		s.Score += score
+		s.Repos++
+		total += score
+	}
+	for _, r := range repos {
+		add(r.Language, r.LanguageColor, r.Score)
+	}
+	for _, p := range projects {
+		add(p.Language, p.LanguageColor, p.Score)
+	}
+
+	shares := make([]domain.LanguageShare, 0, len(byLanguage))
+	for _, s := range byLanguage {
+		s.Share = s.Score / total
+		shares = append(shares, *s)
+	}
+	slices.SortFunc(shares, func(a, b domain.LanguageShare) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(a.Language, b.Language))
+	})
+	return shares
+}
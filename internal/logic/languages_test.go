@@ -0,0 +1,40 @@
+package logic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestShareByLanguage(t *testing.T) {
+	repos := []domain.RepoContribution{
+		{Repo: "golang/go", Language: "Go", LanguageColor: "#00ADD8", Score: 50},
+		{Repo: "rust-lang/rust", Language: "Rust", LanguageColor: "#dea584", Score: 20},
+		{Repo: "org/docs", Score: 40}, // No detected language
+		{Repo: "org/idle", Language: "Python", Score: 0},
+	}
+	projects := []domain.OwnedProjectImpact{
+		{Repo: "me/tool", Language: "Go", LanguageColor: "#00ADD8", Score: 30},
+	}
+
+	shares := ShareByLanguage(repos, projects)
+
+	if len(shares) != 2 {
+		t.Fatalf("expected Go and Rust only, got %+v", shares)
+	}
+	gotGo, gotRust := shares[0], shares[1]
+	if gotGo.Language != "Go" || gotGo.Color != "#00ADD8" || gotGo.Score != 80 || gotGo.Repos != 2 {
+		t.Errorf("expected Go first with 80 from 2 repos, got %+v", gotGo)
+	}
+	if math.Abs(gotGo.Share-0.8) > 1e-9 || math.Abs(gotRust.Share-0.2) > 1e-9 {
+		t.Errorf("expected shares 0.8 and 0.2, got %v and %v", gotGo.Share, gotRust.Share)
+	}
+}
+
+func TestShareByLanguage_NoLanguages(t *testing.T) {
+	shares := ShareByLanguage([]domain.RepoContribution{{Repo: "a/b", Score: 10}}, nil)
+	if len(shares) != 0 {
+		t.Errorf("expected no shares, got %+v", shares)
+	}
+}
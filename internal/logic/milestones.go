@@ -0,0 +1,129 @@
+package logic
+
+import (
+	"sort"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// PopularRepoTiers are the star thresholds that earn a "first contribution to
+// a N-star repo" milestone.
+var PopularRepoTiers = []int{1000, 10000, 100000}
+
+// DetectMilestones derives first-time highlights from the earliest event per
+// external repository. Owned repositories are skipped, matching Aggregate.
+// Milestones are presentation-only and never contribute to scores.
+func DetectMilestones(events []domain.SemanticEvent, projects []domain.EnrichedProject, now time.Time) []domain.Milestone {
+	ownedRepos := make(map[string]bool)
+	for _, p := range projects {
+		ownedRepos[p.Repo] = true
+	}
+
+	var external []domain.SemanticEvent
+	for _, e := range events {
+		if !ownedRepos[e.Repo] {
+			external = append(external, e)
+		}
+	}
+
+	// Chronological with URL tiebreaker, mirroring ScoreBatch ordering
+	sort.SliceStable(external, func(i, j int) bool {
+		if external[i].CreatedAt.Equal(external[j].CreatedAt) {
+			return external[i].URL < external[j].URL
+		}
+		return external[i].CreatedAt.Before(external[j].CreatedAt)
+	})
+
+	var milestones []domain.Milestone
+
+	// 1. First merged PR per repo. Merge date wins over creation date when known.
+	firstMerged := make(map[string]domain.SemanticEvent)
+	for _, e := range external {
+		if e.Type != domain.SemanticEventPrOpened || !e.Merged {
+			continue
+		}
+		prev, ok := firstMerged[e.Repo]
+		if !ok || mergedDate(e).Before(mergedDate(prev)) {
+			firstMerged[e.Repo] = e
+		}
+	}
+	for repo, e := range firstMerged {
+		milestones = append(milestones, domain.Milestone{
+			Kind:       domain.MilestoneFirstMergedPR,
+			Repo:       repo,
+			URL:        e.URL,
+			Title:      e.Title,
+			AchievedAt: mergedDate(e),
+			Stars:      e.Stars,
+			FirstTimer: e.FirstTimer,
+		})
+	}
+
+	// 2. First contribution to a repo above each popularity tier
+	for _, tier := range PopularRepoTiers {
+		for _, e := range external {
+			if e.Stars >= tier {
+				milestones = append(milestones, domain.Milestone{
+					Kind:       domain.MilestoneFirstPopularRepo,
+					Repo:       e.Repo,
+					URL:        e.URL,
+					Title:      e.Title,
+					AchievedAt: e.CreatedAt,
+					Stars:      e.Stars,
+					Threshold:  tier,
+				})
+				break
+			}
+		}
+	}
+
+	// 3. Repos first contributed to during the current calendar year
+	firstSeen := make(map[string]time.Time)
+	for _, e := range external {
+		if _, ok := firstSeen[e.Repo]; !ok {
+			firstSeen[e.Repo] = e.CreatedAt
+		}
+	}
+	newRepos := 0
+	var latest time.Time
+	for _, at := range firstSeen {
+		if at.Year() != now.Year() {
+			continue
+		}
+		newRepos++
+		if at.After(latest) {
+			latest = at
+		}
+	}
+	if newRepos > 0 {
+		milestones = append(milestones, domain.Milestone{
+			Kind:       domain.MilestoneNewReposThisYear,
+			AchievedAt: latest,
+			Count:      newRepos,
+			Year:       now.Year(),
+		})
+	}
+
+	sort.Slice(milestones, func(i, j int) bool {
+		if !milestones[i].AchievedAt.Equal(milestones[j].AchievedAt) {
+			return milestones[i].AchievedAt.Before(milestones[j].AchievedAt)
+		}
+		if milestones[i].Kind != milestones[j].Kind {
+			return milestones[i].Kind < milestones[j].Kind
+		}
+		if milestones[i].Threshold != milestones[j].Threshold {
+			return milestones[i].Threshold < milestones[j].Threshold
+		}
+		return milestones[i].Repo < milestones[j].Repo
+	})
+
+	return milestones
+}
+
+func mergedDate(e domain.SemanticEvent) time.Time {
+	if !e.MergedAt.IsZero() {
+		return e.MergedAt
+	}
+	return e.CreatedAt
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestDetectMilestones(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	projects := []domain.EnrichedProject{
+		{OwnedProject: domain.OwnedProject{Repo: "me/owned"}},
+	}
+
+	events := []domain.SemanticEvent{
+		{Type: domain.SemanticEventIssueOpened, Repo: "big/repo", Stars: 12000, URL: "u1", CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Type: domain.SemanticEventPrOpened, Repo: "big/repo", Stars: 12000, URL: "u2", Merged: true, FirstTimer: true,
+			CreatedAt: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC), MergedAt: time.Date(2021, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{Type: domain.SemanticEventPrOpened, Repo: "big/repo", Stars: 12000, URL: "u3", Merged: true, CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Type: domain.SemanticEventPrOpened, Repo: "small/repo", Stars: 10, URL: "u4", Merged: false, CreatedAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Type: domain.SemanticEventPrOpened, Repo: "me/owned", Stars: 500000, URL: "u5", Merged: true, CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	milestones := DetectMilestones(events, projects, now)
+
+	byKind := make(map[domain.MilestoneKind][]domain.Milestone)
+	for _, m := range milestones {
+		if m.Repo == "me/owned" {
+			t.Fatalf("expected owned repo to be excluded, got %+v", m)
+		}
+		byKind[m.Kind] = append(byKind[m.Kind], m)
+	}
+
+	merged := byKind[domain.MilestoneFirstMergedPR]
+	if len(merged) != 1 {
+		t.Fatalf("expected 1 first-merged-PR milestone, got %d", len(merged))
+	}
+	if merged[0].URL != "u2" || !merged[0].FirstTimer {
+		t.Errorf("expected earliest merged PR u2 flagged as first-timer, got %+v", merged[0])
+	}
+	if !merged[0].AchievedAt.Equal(time.Date(2021, 2, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected merge date to be used, got %v", merged[0].AchievedAt)
+	}
+
+	popular := byKind[domain.MilestoneFirstPopularRepo]
+	if len(popular) != 2 {
+		t.Fatalf("expected 1k and 10k tier milestones, got %d", len(popular))
+	}
+	for _, m := range popular {
+		if m.URL != "u1" {
+			t.Errorf("expected first event u1 for tier %d, got %s", m.Threshold, m.URL)
+		}
+	}
+
+	newRepos := byKind[domain.MilestoneNewReposThisYear]
+	if len(newRepos) != 1 || newRepos[0].Count != 1 || newRepos[0].Year != 2025 {
+		t.Fatalf("expected 1 new repo in 2025, got %+v", newRepos)
+	}
+
+	// Chronological ordering
+	for i := 1; i < len(milestones); i++ {
+		if milestones[i].AchievedAt.Before(milestones[i-1].AchievedAt) {
+			t.Fatalf("expected milestones in chronological order, got %+v", milestones)
+		}
+	}
+}
+
+func TestDetectMilestones_EmptyInput(t *testing.T) {
+	if got := DetectMilestones(nil, nil, time.Now()); len(got) != 0 {
+		t.Fatalf("expected no milestones, got %+v", got)
+	}
+}
@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// AggregateOrg combines members' scored events into an org footprint.
+// Each member is aggregated on their own so per-member decay and scores match
+// an individual run. An event a member's fetches returned twice, keyed by type
+// and ID, counts once. Events of different members are never merged: reviews
+// carry the reviewed PR's ID, so two members reviewing one PR share a key but
+// are separate contributions. Repos owned by scope.Org are internal and
+// skipped entirely.
+func AggregateOrg(scope domain.OrgScope, members []domain.MemberActivity, config domain.ScoringConfig) domain.OrgFootprint {
+	sorted := make([]domain.MemberActivity, len(members))
+	copy(sorted, members)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].User.Username < sorted[j].User.Username
+	})
+
+	footprint := domain.OrgFootprint{Scope: scope}
+	repoMembers := make(map[string]map[string]bool)
+	var deduped []domain.SemanticEvent
+
+	for _, m := range sorted {
+		var external []domain.SemanticEvent
+		seen := make(map[string]bool)
+		for _, e := range m.Events {
+			if isOrgOwned(scope.Org, e.Repo) {
+				continue
+			}
+
+			key := string(e.Type) + ":" + e.ID
+			if e.ID != "" && seen[key] {
+				continue
+			}
+			seen[key] = true
+			external = append(external, e)
+			deduped = append(deduped, e)
+
+			if repoMembers[e.Repo] == nil {
+				repoMembers[e.Repo] = make(map[string]bool)
+			}
+			repoMembers[e.Repo][m.User.Username] = true
+		}
+
+		stats, repos, _ := Aggregate(external, nil, config)
+		sortRepoContributions(repos)
+		score := 0.0
+		for _, r := range repos {
+			score += r.Score
+		}
+		footprint.Members = append(footprint.Members, domain.MemberFootprint{
+			User:  m.User,
+			Stats: stats,
+			Score: score,
+			Repos: repos,
+		})
+	}
+
+	stats, repos, _ := Aggregate(deduped, nil, config)
+	sortRepoContributions(repos)
+	footprint.Stats = stats
+	footprint.Events = len(deduped)
+	for _, r := range repos {
+		var names []string
+		for name := range repoMembers[r.Repo] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		footprint.Upstreams = append(footprint.Upstreams, domain.UpstreamImpact{RepoContribution: r, Members: names})
+		footprint.TotalScore += r.Score
+		footprint.LifetimeScore += r.LifetimeScore
+	}
+
+	sort.SliceStable(footprint.Members, func(i, j int) bool {
+		return footprint.Members[i].Score > footprint.Members[j].Score
+	})
+
+	return footprint
+}
+
+func isOrgOwned(org, repo string) bool {
+	if org == "" {
+		return false
+	}
+	owner, _, _ := strings.Cut(repo, "/")
+	return strings.EqualFold(owner, org)
+}
+
+// sortRepoContributions orders by Score desc, then Repo asc.
+func sortRepoContributions(repos []domain.RepoContribution) {
+	sort.Slice(repos, func(i, j int) bool {
+		if repos[i].Score != repos[j].Score {
+			return repos[i].Score > repos[j].Score
+		}
+		return repos[i].Repo < repos[j].Repo
+	})
+}
@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestAggregateOrg(t *testing.T) {
+	scope := domain.OrgScope{Org: "acme", Name: "acme"}
+	members := []domain.MemberActivity{
+		{
+			User: domain.User{Username: "bo"},
+			Events: []domain.SemanticEvent{
+				{ID: "pr1", Type: domain.SemanticEventPrOpened, Repo: "up/stream", BaseScore: 10, PopularityRaw: 1.0},
+				// Reviews carry the reviewed PR's ID, shared with ana's review
+				{ID: "pr3", Type: domain.SemanticEventPrReview, Repo: "up/stream", BaseScore: 3, PopularityRaw: 1.0},
+				{ID: "i1", Type: domain.SemanticEventIssueOpened, Repo: "Acme/internal", BaseScore: 5, PopularityRaw: 1.0},
+			},
+		},
+		{
+			User: domain.User{Username: "ana"},
+			Events: []domain.SemanticEvent{
+				{ID: "pr3", Type: domain.SemanticEventPrReview, Repo: "up/stream", BaseScore: 3, PopularityRaw: 1.0},
+				{ID: "pr2", Type: domain.SemanticEventPrOpened, Repo: "other/lib", BaseScore: 10, PopularityRaw: 2.0},
+				// Returned twice by ana's own fetches
+				{ID: "pr2", Type: domain.SemanticEventPrOpened, Repo: "other/lib", BaseScore: 10, PopularityRaw: 2.0},
+			},
+		},
+	}
+
+	org := AggregateOrg(scope, members, testConfig)
+
+	if org.Events != 4 {
+		t.Errorf("expected 4 deduplicated events, got %d", org.Events)
+	}
+	if org.Stats.PRsOpened != 2 || org.Stats.PRReviews != 2 || org.Stats.IssuesOpened != 0 {
+		t.Errorf("unexpected org stats %+v", org.Stats)
+	}
+	if len(org.Upstreams) != 2 {
+		t.Fatalf("expected 2 upstreams (org-owned repo excluded), got %d", len(org.Upstreams))
+	}
+
+	// other/lib: 10 * 2.0 = 20; up/stream: (10 + 3 + 3) * 1.0 = 16
+	if org.Upstreams[0].Repo != "other/lib" || org.Upstreams[1].Repo != "up/stream" {
+		t.Errorf("expected upstreams sorted by score, got %s, %s", org.Upstreams[0].Repo, org.Upstreams[1].Repo)
+	}
+	if !reflect.DeepEqual(org.Upstreams[1].Members, []string{"ana", "bo"}) {
+		t.Errorf("expected up/stream members [ana bo], got %v", org.Upstreams[1].Members)
+	}
+	if org.Upstreams[1].Score != 16 || org.Upstreams[1].PRReviews != 2 {
+		t.Errorf("expected both reviews of the shared PR to count on up/stream, got %+v", org.Upstreams[1].RepoContribution)
+	}
+	if org.TotalScore != 36 {
+		t.Errorf("expected total score 36, got %f", org.TotalScore)
+	}
+
+	// Each member is credited for their own review
+	if len(org.Members) != 2 || org.Members[0].User.Username != "ana" {
+		t.Fatalf("expected ana first by score, got %+v", org.Members)
+	}
+	if org.Members[0].Score != 23 || org.Members[1].Score != 13 {
+		t.Errorf("expected member scores 23 and 13, got %f and %f", org.Members[0].Score, org.Members[1].Score)
+	}
+	if org.Members[1].Stats.IssuesOpened != 0 {
+		t.Errorf("expected org-owned issue to be excluded from member stats")
+	}
+}
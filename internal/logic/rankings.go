@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"sort"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// DiffRankings compares the repo rankings of the same footprint scored two
+// ways. Repos are ranked by score, ties broken by name, and the result is
+// ordered by the new ranking. A repo missing from one side gets rank 0.
+func DiffRankings(before, after []domain.RepoContribution) []domain.RankChange {
+	changes := make(map[string]*domain.RankChange)
+	get := func(repo string) *domain.RankChange {
+		if _, ok := changes[repo]; !ok {
+			changes[repo] = &domain.RankChange{Repo: repo}
+		}
+		return changes[repo]
+	}
+
+	for i, r := range rankRepos(before) {
+		c := get(r.Repo)
+		c.BeforeRank = i + 1
+		c.BeforeScore = r.Score
+	}
+	for i, r := range rankRepos(after) {
+		c := get(r.Repo)
+		c.AfterRank = i + 1
+		c.AfterScore = r.Score
+	}
+
+	diff := make([]domain.RankChange, 0, len(changes))
+	for _, c := range changes {
+		diff = append(diff, *c)
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		if (diff[i].AfterRank == 0) != (diff[j].AfterRank == 0) {
+			return diff[j].AfterRank == 0
+		}
+		if diff[i].AfterRank != diff[j].AfterRank {
+			return diff[i].AfterRank < diff[j].AfterRank
+		}
+		return diff[i].BeforeRank < diff[j].BeforeRank
+	})
+	return diff
+}
+
+func rankRepos(repos []domain.RepoContribution) []domain.RepoContribution {
+	ranked := make([]domain.RepoContribution, len(repos))
+	copy(ranked, repos)
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].Repo < ranked[j].Repo
+	})
+	return ranked
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestDiffRankings(t *testing.T) {
+	before := []domain.RepoContribution{
+		{Repo: "a/one", Score: 30},
+		{Repo: "b/two", Score: 20},
+		{Repo: "c/three", Score: 10},
+	}
+	after := []domain.RepoContribution{
+		{Repo: "a/one", Score: 15},
+		{Repo: "b/two", Score: 40},
+		{Repo: "c/three", Score: 15},
+	}
+
+	diff := DiffRankings(before, after)
+	want := []domain.RankChange{
+		{Repo: "b/two", BeforeRank: 2, AfterRank: 1, BeforeScore: 20, AfterScore: 40},
+		{Repo: "a/one", BeforeRank: 1, AfterRank: 2, BeforeScore: 30, AfterScore: 15},
+		{Repo: "c/three", BeforeRank: 3, AfterRank: 3, BeforeScore: 10, AfterScore: 15},
+	}
+	if len(diff) != len(want) {
+		t.Fatalf("expected %d changes, got %+v", len(want), diff)
+	}
+	for i := range want {
+		if diff[i] != want[i] {
+			t.Errorf("change %d: expected %+v, got %+v", i, want[i], diff[i])
+		}
+	}
+	if diff[0].Moved() != 1 || diff[1].Moved() != -1 {
+		t.Errorf("expected b/two up one and a/one down one, got %d and %d", diff[0].Moved(), diff[1].Moved())
+	}
+}
+
+func TestDiffRankings_RepoOnOneSide(t *testing.T) {
+	diff := DiffRankings(
+		[]domain.RepoContribution{{Repo: "a/one", Score: 5}},
+		[]domain.RepoContribution{{Repo: "b/two", Score: 5}},
+	)
+	if len(diff) != 2 || diff[0].Repo != "b/two" || diff[1].AfterRank != 0 || diff[1].BeforeRank != 1 {
+		t.Errorf("expected dropped repos last with rank 0, got %+v", diff)
+	}
+}
@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// BucketActivity aggregates events by day, week and month. Each event scores
+// what it adds to its repo's lifetime score: its base score times the repo's
+// capped peak popularity, or a release's own. Recency weighting is left out
+// so a period keeps its score as it ages. Events in owned repos, which
+// Aggregate leaves out of repo scores, are counted with a zero score.
+func BucketActivity(events []domain.SemanticEvent, repos []domain.RepoContribution, config domain.ScoringConfig) domain.TimeSeries {
+	peak := make(map[string]float64, len(repos))
+	for _, r := range repos {
+		peak[r.Repo] = r.PopularityRaw
+	}
+
+	granularities := []domain.Granularity{domain.GranularityDay, domain.GranularityWeek, domain.GranularityMonth}
+	buckets := make(map[domain.Granularity]map[int64]*domain.TimeBucket, len(granularities))
+	for _, g := range granularities {
+		buckets[g] = make(map[int64]*domain.TimeBucket)
+	}
+
+	for _, e := range events {
+		if e.CreatedAt.IsZero() {
+			continue
+		}
+		var score float64
+		if raw, ok := peak[e.Repo]; ok {
+			if e.Type == domain.SemanticEventReleasePublished {
+				raw = e.PopularityRaw
+			}
+			score = e.BaseScore * cappedMultiplier(raw, config.MultiplierCap())
+		}
+
+		for _, g := range granularities {
+			start := domain.BucketStart(e.CreatedAt, g)
+			b, ok := buckets[g][start.Unix()]
+			if !ok {
+				b = &domain.TimeBucket{
+					Start: start,
+					Types: make(map[domain.SemanticEventType]domain.Activity),
+					Repos: make(map[string]domain.Activity),
+				}
+				buckets[g][start.Unix()] = b
+			}
+			b.Add(score)
+			byType := b.Types[e.Type]
+			byType.Add(score)
+			b.Types[e.Type] = byType
+			byRepo := b.Repos[e.Repo]
+			byRepo.Add(score)
+			b.Repos[e.Repo] = byRepo
+		}
+	}
+
+	series := func(g domain.Granularity) []domain.TimeBucket {
+		out := make([]domain.TimeBucket, 0, len(buckets[g]))
+		for _, b := range buckets[g] {
+			out = append(out, *b)
+		}
+		slices.SortFunc(out, func(a, b domain.TimeBucket) int {
+			return cmp.Compare(a.Start.Unix(), b.Start.Unix())
+		})
+		return out
+	}
+	return domain.TimeSeries{
+		Day:   series(domain.GranularityDay),
+		Week:  series(domain.GranularityWeek),
+		Month: series(domain.GranularityMonth),
+	}
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestBucketActivity(t *testing.T) {
+	config := domain.ScoringConfig{RepoMultiplierCap: 4}
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+	events := []domain.SemanticEvent{
+		{Type: domain.SemanticEventPrOpened, Repo: "a/b", BaseScore: 10, CreatedAt: day(2)}, // Monday
+		{Type: domain.SemanticEventPrReview, Repo: "a/b", BaseScore: 5, CreatedAt: day(2)},  // Same day
+		{Type: domain.SemanticEventPrOpened, Repo: "c/d", BaseScore: 10, CreatedAt: day(8)}, // Sunday, same week
+		{Type: domain.SemanticEventReleasePublished, Repo: "a/b", BaseScore: 2, PopularityRaw: 1.5, CreatedAt: day(20)},
+		{Type: domain.SemanticEventIssueOpened, Repo: "me/own", BaseScore: 4, CreatedAt: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	repos := []domain.RepoContribution{
+		{Repo: "a/b", PopularityRaw: 2},
+		{Repo: "c/d", PopularityRaw: 9}, // Capped at 4
+	}
+
+	series := BucketActivity(events, repos, config)
+
+	if len(series.Day) != 4 || len(series.Week) != 3 || len(series.Month) != 2 {
+		t.Fatalf("expected 4 days, 3 weeks and 2 months, got %d, %d and %d", len(series.Day), len(series.Week), len(series.Month))
+	}
+
+	first := series.Day[0]
+	if first.Count != 2 || first.Score != 30 {
+		t.Errorf("expected 2 events scoring (10+5)×2 on the first day, got %+v", first.Activity)
+	}
+	if first.Types[domain.SemanticEventPrReview] != (domain.Activity{Count: 1, Score: 10}) {
+		t.Errorf("expected the review split out by type, got %+v", first.Types)
+	}
+
+	week := series.Week[0]
+	if !week.Start.Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)) || week.Count != 3 || week.Score != 70 {
+		t.Errorf("expected Monday to Sunday in one week scoring 30+40, got %+v", week)
+	}
+	if week.Repos["c/d"] != (domain.Activity{Count: 1, Score: 40}) {
+		t.Errorf("expected c/d capped at the multiplier cap, got %+v", week.Repos)
+	}
+
+	march := series.Month[0]
+	if march.Count != 4 || march.Score != 73 || march.Repos["a/b"].Score != 33 {
+		t.Errorf("expected the release scored by its own popularity, got %+v", march)
+	}
+	april := series.Month[1]
+	if april.Count != 1 || april.Score != 0 {
+		t.Errorf("expected owned repo events counted without a score, got %+v", april)
+	}
+}
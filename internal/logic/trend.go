@@ -0,0 +1,140 @@
+package logic
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// TrendPeriod is how far back a trend looks. A run is compared with the
+// latest snapshot at least this old, so daily runs still report the week.
+const TrendPeriod = 7 * 24 * time.Hour
+
+// repoDeltaEpsilon hides score changes that are only rounding noise.
+const repoDeltaEpsilon = 0.005
+
+// TakeSnapshot records a finalized footprint for the history file.
+func TakeSnapshot(takenAt time.Time, scoringHash string, stats domain.StatsView, repos []domain.RepoContribution, projects []domain.OwnedProjectImpact) domain.Snapshot {
+	totals := domain.TotalScores(repos, projects, stats.Private)
+	scores := make(map[string]float64, len(repos)+len(projects))
+	stars := make(map[string]int, len(projects))
+	for _, r := range repos {
+		scores[r.Repo] += r.Score
+	}
+	for _, p := range projects {
+		scores[p.Repo] += p.Score
+		stars[p.Repo] = p.Stars
+	}
+	return domain.Snapshot{
+		TakenAt:       takenAt,
+		ScoringHash:   scoringHash,
+		Score:         totals.Recent,
+		LifetimeScore: totals.Lifetime,
+		Stats:         stats,
+		Repos:         scores,
+		ProjectStars:  stars,
+	}
+}
+
+// ComputeTrend compares current with the latest earlier snapshot taken at
+// least TrendPeriod before it, or with the oldest one when none is that old.
+// It returns nil when there is no earlier snapshot.
+func ComputeTrend(history []domain.Snapshot, current domain.Snapshot) *domain.Trend {
+	var base, oldest *domain.Snapshot
+	cutoff := current.TakenAt.Add(-TrendPeriod)
+	for i := range history {
+		s := &history[i]
+		if !s.TakenAt.Before(current.TakenAt) {
+			continue
+		}
+		if oldest == nil || s.TakenAt.Before(oldest.TakenAt) {
+			oldest = s
+		}
+		if !s.TakenAt.After(cutoff) && (base == nil || s.TakenAt.After(base.TakenAt)) {
+			base = s
+		}
+	}
+	if base == nil {
+		base = oldest
+	}
+	if base == nil {
+		return nil
+	}
+
+	trend := &domain.Trend{
+		Since:          base.TakenAt,
+		Score:          current.Score - base.Score,
+		LifetimeScore:  current.LifetimeScore - base.LifetimeScore,
+		Stats:          statsDelta(current.Stats, base.Stats),
+		ScoringChanged: base.ScoringHash != "" && current.ScoringHash != "" && base.ScoringHash != current.ScoringHash,
+	}
+	trend.NewRepos, trend.Repos = diffRepos(base.Repos, current.Repos)
+	return trend
+}
+
+// diffRepos lists the repos scored in after but not before, sorted, and the
+// score changes of the rest, largest first. Repos that dropped out count as
+// falling to zero.
+func diffRepos(before, after map[string]float64) ([]string, []domain.RepoDelta) {
+	var added []string
+	var deltas []domain.RepoDelta
+	for repo, score := range after {
+		prev, ok := before[repo]
+		if !ok {
+			added = append(added, repo)
+			continue
+		}
+		if delta := score - prev; math.Abs(delta) >= repoDeltaEpsilon {
+			deltas = append(deltas, domain.RepoDelta{Repo: repo, Score: delta})
+		}
+	}
+	// Repos that dropped out, e.g. past a rolling -since window
+	for repo, prev := range before {
+		if _, ok := after[repo]; !ok && prev >= repoDeltaEpsilon {
+			deltas = append(deltas, domain.RepoDelta{Repo: repo, Score: -prev})
+		}
+	}
+	slices.Sort(added)
+	slices.SortFunc(deltas, func(a, b domain.RepoDelta) int {
+		return cmp.Or(cmp.Compare(math.Abs(b.Score), math.Abs(a.Score)), cmp.Compare(a.Repo, b.Repo))
+	})
+	return added, deltas
+}
+
+// statsDelta subtracts before from after, stat by stat.
+func statsDelta(after, before domain.StatsView) domain.StatsView {
+	d := domain.StatsView{
+		PRsOpened:               after.PRsOpened - before.PRsOpened,
+		PRReviews:               after.PRReviews - before.PRReviews,
+		PRReviewComments:        after.PRReviewComments - before.PRReviewComments,
+		IssuesOpened:            after.IssuesOpened - before.IssuesOpened,
+		IssueComments:           after.IssueComments - before.IssueComments,
+		DiscussionsOpened:       after.DiscussionsOpened - before.DiscussionsOpened,
+		DiscussionComments:      after.DiscussionComments - before.DiscussionComments,
+		TotalCommits:            after.TotalCommits - before.TotalCommits,
+		SecurityAdvisories:      after.SecurityAdvisories - before.SecurityAdvisories,
+		ReleasesPublished:       after.ReleasesPublished - before.ReleasesPublished,
+		TriageActions:           after.TriageActions - before.TriageActions,
+		ProjectsOwned:           after.ProjectsOwned - before.ProjectsOwned,
+		StarsEarned:             after.StarsEarned - before.StarsEarned,
+		TotalReposContributedTo: after.TotalReposContributedTo - before.TotalReposContributedTo,
+	}
+	if after.Private != nil {
+		var prev domain.PrivateContributions
+		if before.Private != nil {
+			prev = *before.Private
+		}
+		d.Private = &domain.PrivateContributions{
+			Restricted:   after.Private.Restricted - prev.Restricted,
+			Commits:      after.Private.Commits - prev.Commits,
+			Issues:       after.Private.Issues - prev.Issues,
+			PullRequests: after.Private.PullRequests - prev.PullRequests,
+			Reviews:      after.Private.Reviews - prev.Reviews,
+			Score:        after.Private.Score - prev.Score,
+		}
+	}
+	return d
+}
@@ -0,0 +1,108 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestTakeSnapshot(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	stats := domain.StatsView{PRsOpened: 3, Private: &domain.PrivateContributions{Commits: 4, Score: 2}}
+	repos := []domain.RepoContribution{{Repo: "a/b", Score: 10, LifetimeScore: 15}}
+	projects := []domain.OwnedProjectImpact{{Repo: "me/tool", Score: 5}}
+
+	s := TakeSnapshot(now, "abc", stats, repos, projects)
+
+	if s.Score != 17 || s.LifetimeScore != 22 {
+		t.Errorf("expected totals 17 and 22 including private, got %v and %v", s.Score, s.LifetimeScore)
+	}
+	if s.Repos["a/b"] != 10 || s.Repos["me/tool"] != 5 || len(s.Repos) != 2 {
+		t.Errorf("expected external and owned repo scores, got %v", s.Repos)
+	}
+	if !s.TakenAt.Equal(now) || s.ScoringHash != "abc" || s.Stats.PRsOpened != 3 {
+		t.Errorf("expected time, hash and stats to be recorded, got %+v", s)
+	}
+}
+
+func TestComputeTrend_PicksBaseline(t *testing.T) {
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	snapshot := func(age time.Duration, score float64) domain.Snapshot {
+		return domain.Snapshot{TakenAt: now.Add(-age), Score: score}
+	}
+
+	tests := []struct {
+		name    string
+		history []domain.Snapshot
+		want    float64 // Baseline score; -1 for no trend
+	}{
+		{"no history", nil, -1},
+		{"latest snapshot a week old", []domain.Snapshot{snapshot(30*day, 1), snapshot(8*day, 2), snapshot(7*day, 3), snapshot(1*day, 4)}, 3},
+		{"only recent snapshots fall back to the oldest", []domain.Snapshot{snapshot(2*day, 5), snapshot(3*day, 6), snapshot(day, 7)}, 6},
+		{"future snapshots ignored", []domain.Snapshot{snapshot(-day, 8)}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trend := ComputeTrend(tt.history, domain.Snapshot{TakenAt: now, Score: 100})
+			if tt.want < 0 {
+				if trend != nil {
+					t.Fatalf("expected no trend, got %+v", trend)
+				}
+				return
+			}
+			if trend == nil {
+				t.Fatalf("expected a trend")
+			}
+			if got := 100 - trend.Score; got != tt.want {
+				t.Errorf("expected baseline score %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestComputeTrend_Deltas(t *testing.T) {
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	base := domain.Snapshot{
+		TakenAt:     now.Add(-7 * 24 * time.Hour),
+		ScoringHash: "old",
+		Score:       50,
+		Stats:       domain.StatsView{PRsOpened: 4, StarsEarned: 100},
+		Repos:       map[string]float64{"a/b": 30, "c/d": 20, "e/f": 1, "g/h": 0.001},
+	}
+	current := domain.Snapshot{
+		TakenAt:     now,
+		ScoringHash: "new",
+		Score:       84,
+		Stats:       domain.StatsView{PRsOpened: 6, StarsEarned: 90, Private: &domain.PrivateContributions{Commits: 3}},
+		Repos:       map[string]float64{"a/b": 30.001, "c/d": 44, "x/y": 9, "new/one": 1},
+	}
+
+	trend := ComputeTrend([]domain.Snapshot{base}, current)
+
+	if trend.Score != 34 || !trend.Since.Equal(base.TakenAt) {
+		t.Errorf("expected +34 since the baseline, got %+v", trend)
+	}
+	if trend.Stats.PRsOpened != 2 || trend.Stats.StarsEarned != -10 {
+		t.Errorf("expected stat deltas +2 PRs and -10 stars, got %+v", trend.Stats)
+	}
+	if trend.Stats.Private == nil || trend.Stats.Private.Commits != 3 {
+		t.Errorf("expected private stats new since the baseline to count in full, got %+v", trend.Stats.Private)
+	}
+	if len(trend.NewRepos) != 2 || trend.NewRepos[0] != "new/one" || trend.NewRepos[1] != "x/y" {
+		t.Errorf("expected sorted new repos, got %v", trend.NewRepos)
+	}
+	want := []domain.RepoDelta{{Repo: "c/d", Score: 24}, {Repo: "e/f", Score: -1}}
+	if len(trend.Repos) != len(want) {
+		t.Fatalf("expected repo deltas %v, got %v", want, trend.Repos)
+	}
+	for i := range want {
+		if trend.Repos[i] != want[i] {
+			t.Errorf("expected repo delta %d to be %v, got %v", i, want[i], trend.Repos[i])
+		}
+	}
+	if !trend.ScoringChanged {
+		t.Errorf("expected a different scoring hash to be flagged")
+	}
+}
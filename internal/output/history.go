@@ -0,0 +1,79 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// HistoryFilename is the history file kept next to the other artifacts.
+const HistoryFilename = "history.jsonl"
+
+// HistoryFile is an append-only JSON Lines file of snapshots, one per run.
+type HistoryFile struct {
+	Path string
+}
+
+func NewHistoryFile(path string) *HistoryFile {
+	return &HistoryFile{Path: path}
+}
+
+// LoadHistory reads every snapshot in file order. A missing file is an empty
+// history.
+func (h *HistoryFile) LoadHistory(ctx context.Context) ([]domain.Snapshot, error) {
+	data, err := os.ReadFile(h.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading history: %w", err)
+	}
+
+	var snapshots []domain.Snapshot
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var s domain.Snapshot
+		if err := json.Unmarshal(text, &s); err != nil {
+			return nil, fmt.Errorf("parsing %s line %d: %w", h.Path, line, err)
+		}
+		snapshots = append(snapshots, s)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading history: %w", err)
+	}
+	return snapshots, nil
+}
+
+// AppendSnapshot adds snapshot as the file's last line, creating the file
+// and its directory when needed.
+func (h *HistoryFile) AppendSnapshot(ctx context.Context, snapshot domain.Snapshot) error {
+	line, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("marshaling snapshot: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(h.Path), 0755); err != nil {
+		return fmt.Errorf("creating history directory: %w", err)
+	}
+	f, err := os.OpenFile(h.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening history: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("appending to history: %w", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,70 @@
+package output
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestHistoryFileAppendsAndLoads(t *testing.T) {
+	ctx := context.Background()
+	history := NewHistoryFile(filepath.Join(t.TempDir(), "nested", HistoryFilename))
+
+	snapshots, err := history.LoadHistory(ctx)
+	if err != nil {
+		t.Fatalf("expected a missing file to load as empty history, got %v", err)
+	}
+	if len(snapshots) != 0 {
+		t.Fatalf("expected no snapshots, got %d", len(snapshots))
+	}
+
+	first := domain.Snapshot{
+		TakenAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Score:   10,
+		Stats:   domain.StatsView{PRsOpened: 2},
+		Repos:   map[string]float64{"a/b": 10},
+	}
+	second := first
+	second.TakenAt = first.TakenAt.Add(24 * time.Hour)
+	second.Score = 12
+	for _, s := range []domain.Snapshot{first, second} {
+		if err := history.AppendSnapshot(ctx, s); err != nil {
+			t.Fatalf("expected no error appending, got %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(history.Path)
+	if err != nil {
+		t.Fatalf("expected history file to exist, got %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 2 {
+		t.Fatalf("expected one line per snapshot, got %d lines", lines)
+	}
+
+	snapshots, err = history.LoadHistory(ctx)
+	if err != nil {
+		t.Fatalf("expected no error loading, got %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[0].Score != 10 || snapshots[1].Score != 12 {
+		t.Fatalf("expected both snapshots in order, got %+v", snapshots)
+	}
+	if snapshots[1].Repos["a/b"] != 10 || snapshots[1].Stats.PRsOpened != 2 {
+		t.Errorf("expected repos and stats to round-trip, got %+v", snapshots[1])
+	}
+}
+
+func TestHistoryFileRejectsMalformedLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), HistoryFilename)
+	if err := os.WriteFile(path, []byte("{\"score\": 1}\nnot json\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := NewHistoryFile(path).LoadHistory(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("expected an error naming line 2, got %v", err)
+	}
+}
@@ -44,6 +44,65 @@ func FetchAssets(user domain.User, repos []domain.RepoContribution, projects []d
 	return assets
 }
 
+// orgCardRows matches the rows per section on the org card. Orgs can have
+// hundreds of members, so only avatars that will be shown are fetched.
+const orgCardRows = 3
+
+// FetchOrgAssets retrieves the org, top member and top upstream avatars for an
+// org card. memberRows raises the number of member avatars, e.g. for a leaderboard.
+func FetchOrgAssets(org domain.OrgFootprint, memberRows int) map[domain.AssetKey]string {
+	assets := make(map[domain.AssetKey]string)
+
+	handle := org.Scope.Handle()
+	if org.Scope.Org != "" {
+		assets[domain.UserAvatarKey(handle)] = fetchAsDataURL("https://github.com/" + handle + ".png")
+	}
+
+	for _, m := range org.Members[:min(max(orgCardRows, memberRows), len(org.Members))] {
+		if m.User.AvatarURL != "" {
+			assets[domain.UserAvatarKey(m.User.Username)] = fetchAsDataURL(m.User.AvatarURL)
+		}
+	}
+
+	for _, u := range org.Upstreams[:min(orgCardRows, len(org.Upstreams))] {
+		if u.AvatarURL != "" {
+			key := domain.RepoAvatarKey(u.Repo)
+			if _, ok := assets[key]; !ok {
+				assets[key] = fetchAsDataURL(u.AvatarURL)
+			}
+		}
+	}
+
+	return assets
+}
+
+// FetchCommunityAssets retrieves the owner, top contributor and top reviewer
+// avatars for a community card.
+func FetchCommunityAssets(community domain.CommunityFootprint) map[domain.AssetKey]string {
+	assets := make(map[domain.AssetKey]string)
+
+	owner := community.Scope.Owner
+	if owner != "" {
+		assets[domain.UserAvatarKey(owner)] = fetchAsDataURL("https://github.com/" + owner + ".png")
+	}
+
+	users := make([]domain.User, 0, 2*orgCardRows)
+	for _, c := range community.Contributors[:min(orgCardRows, len(community.Contributors))] {
+		users = append(users, c.User)
+	}
+	for _, r := range community.Reviews.Reviewers[:min(orgCardRows, len(community.Reviews.Reviewers))] {
+		users = append(users, r.User)
+	}
+	for _, u := range users {
+		key := domain.UserAvatarKey(u.Username)
+		if _, ok := assets[key]; !ok && u.AvatarURL != "" {
+			assets[key] = fetchAsDataURL(u.AvatarURL)
+		}
+	}
+
+	return assets
+}
+
 func fetchAsDataURL(url string) string {
 	if url == "" {
 		return ""
@@ -0,0 +1,138 @@
+package card
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func (r Renderer) RenderCommunityCard(ctx context.Context, community domain.CommunityFootprint, generatedAt time.Time, assets map[domain.AssetKey]string) ([]byte, error) {
+	_ = ctx
+	vm := buildCommunityViewModel(community, generatedAt)
+	vm.Footer.Range = r.Window.String()
+	return renderSVG(vm, assets), nil
+}
+
+func buildCommunityViewModel(community domain.CommunityFootprint, generatedAt time.Time) CardViewModel {
+	stats := community.Stats
+	year := generatedAt.Format("2006")
+	newThisYear := 0
+	for _, p := range community.FirstTimers {
+		if strings.HasPrefix(p.Period, year) {
+			newThisYear += p.Count
+		}
+	}
+
+	medianReview := "—"
+	if community.Reviews.Reviewed > 0 {
+		medianReview = formatWait(community.Reviews.MedianFirstReview)
+	}
+
+	// 1. Build Stats. Always show the full grid, like the org card.
+	activeStats := []StatVM{
+		{Label: "Contributors", Value: formatCount(len(community.Contributors)), Icon: iconPeople, Raw: len(community.Contributors)},
+		{Label: "New This Year", Value: formatCount(newThisYear), Icon: iconStar, Raw: newThisYear},
+		{Label: "PRs Opened", Value: formatLargeNum(stats.PRsOpened), Icon: iconPR, Raw: stats.PRsOpened},
+		{Label: "Code Reviews", Value: formatLargeNum(stats.PRReviews), Icon: iconReview, Raw: stats.PRReviews},
+		{Label: "Bus Factor", Value: fmt.Sprintf("%d", community.BusFactor.Authors), Icon: iconShield, Raw: community.BusFactor.Authors},
+		{Label: "Median Review", Value: medianReview, Icon: iconComment, Raw: int(community.Reviews.MedianFirstReview.Hours())},
+	}
+
+	topContributors := community.Contributors[:min(3, len(community.Contributors))]
+	topReviewers := community.Reviews.Reviewers[:min(3, len(community.Reviews.Reviewers))]
+
+	// 2. Decide Layout
+	layout := DecideLayout(LayoutInput{
+		StatCount:    len(activeStats),
+		ShowAllStats: true,
+		Mode:         LayoutHorizontal,
+		Sections: []SectionLayoutInput{
+			{Rows: len(topContributors), IsEmpty: len(topContributors) == 0, Placement: StackHorizontal, Column: 0},
+			{Rows: len(topReviewers), IsEmpty: len(topReviewers) == 0, Placement: StackHorizontal, Column: 1},
+		},
+	})
+
+	// 3. Position Stats
+	for i := range activeStats {
+		activeStats[i].X = (i % 3) * 250
+		activeStats[i].Y = (i / 3) * 90
+		activeStats[i].Color = "#22c55e"
+	}
+
+	// 4. Build Sections
+	var contributorRows []SectionRowVM
+	for _, c := range topContributors {
+		username := c.User.Username
+		badges := []BadgeVM{}
+		if c.Stats.PRsOpened > 0 {
+			badges = append(badges, BadgeVM{Count: fmt.Sprintf("%d", c.Stats.PRsOpened), Icon: iconPR, Link: "https://github.com/" + username})
+		}
+		contributorRows = append(contributorRows, SectionRowVM{
+			Kind:      RowExternalContribution,
+			Title:     truncate(username, 15),
+			Subtitle:  "since " + c.FirstContribution.Format("Jan 2006"),
+			Link:      "https://github.com/" + username,
+			AvatarKey: domain.UserAvatarKey(username),
+			Badges:    badges,
+		})
+	}
+
+	var reviewerRows []SectionRowVM
+	for _, r := range topReviewers {
+		username := r.User.Username
+		reviewerRows = append(reviewerRows, SectionRowVM{
+			Kind:      RowExternalContribution,
+			Title:     truncate(username, 15),
+			Subtitle:  fmt.Sprintf("%.0f%% of reviews", r.Share*100),
+			Link:      "https://github.com/" + username,
+			AvatarKey: domain.UserAvatarKey(username),
+			Badges:    []BadgeVM{{Count: fmt.Sprintf("%d", r.Reviews), Icon: iconReview, Link: "https://github.com/" + username}},
+		})
+	}
+
+	sections := []SectionVM{
+		{Title: "TOP CONTRIBUTORS", EmptyMessage: "No outside contributors yet", Rows: contributorRows},
+		{Title: "TOP REVIEWERS", EmptyMessage: "No reviews yet", Rows: reviewerRows},
+	}
+
+	footer := FooterVM{
+		Y:           layout.Height - 25,
+		GeneratedAt: generatedAt.Format("02 Jan 2006"),
+		Attribution: footerAttribution,
+	}
+
+	// A single repo is titled by its full name, which also links to it
+	title := community.Scope.Owner
+	if len(community.Scope.Repos) == 1 {
+		title = community.Scope.Repos[0]
+	}
+
+	return CardViewModel{
+		Width:      layout.Width,
+		Height:     layout.Height,
+		IsVertical: layout.IsVertical,
+		Layout:     layout,
+		User: UserVM{
+			Username:  title,
+			AvatarKey: domain.UserAvatarKey(community.Scope.Owner),
+		},
+		Stats:    activeStats,
+		Sections: sections,
+		Footer:   footer,
+	}
+}
+
+// formatWait renders a review wait compactly: "<1h", "5h" or "3d".
+func formatWait(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return "<1h"
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
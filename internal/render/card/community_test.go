@@ -0,0 +1,56 @@
+package card
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestRenderCommunityCard(t *testing.T) {
+	community := domain.CommunityFootprint{
+		Scope: domain.CommunityScope{Owner: "ray", Repos: []string{"ray/tool"}},
+		Contributors: []domain.CommunityContributor{
+			{MemberFootprint: domain.MemberFootprint{User: domain.User{Username: "ana"}, Stats: domain.StatsView{PRsOpened: 4}}, FirstContribution: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		FirstTimers: []domain.PeriodCount{{Period: "2024-03", Count: 1}, {Period: "2025-02", Count: 2}},
+		Reviews: domain.ReviewLoad{
+			Reviewed:          3,
+			MedianFirstReview: 72 * time.Hour,
+			Reviewers:         []domain.ReviewerLoad{{User: domain.User{Username: "bo"}, Reviews: 6, Share: 0.75}},
+		},
+		BusFactor: domain.BusFactor{Authors: 2},
+	}
+
+	out, err := Renderer{}.RenderCommunityCard(context.Background(), community, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	svg := string(out)
+	for _, want := range []string{"TOP CONTRIBUTORS", "TOP REVIEWERS", ">ray/tool<", ">ana<", "since Mar 2024", "75% of reviews", "BUS FACTOR", ">3d<"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected community card to contain %q", want)
+		}
+	}
+
+	vm := buildCommunityViewModel(community, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+	if vm.Stats[1].Raw != 2 {
+		t.Errorf("expected 2 new contributors this year, got %d", vm.Stats[1].Raw)
+	}
+}
+
+func TestFormatWait(t *testing.T) {
+	tests := map[time.Duration]string{
+		10 * time.Minute: "<1h",
+		5 * time.Hour:    "5h",
+		50 * time.Hour:   "2d",
+	}
+	for d, want := range tests {
+		if got := formatWait(d); got != want {
+			t.Errorf("formatWait(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
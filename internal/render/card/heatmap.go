@@ -0,0 +1,224 @@
+package card
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+const (
+	heatmapWeeks      = 52
+	heatmapCellSize   = 10
+	heatmapCellPitch  = 13 // Cell size plus the gap between cells
+	heatmapLabelWidth = 30 // Weekday labels left of the grid
+	heatmapMonthRow   = 16 // Month labels above the grid
+	heatmapLegendRow  = 26 // Legend below the grid
+)
+
+// heatmapColors shade a day from no score to the top quartile, in the card's
+// green accent.
+var heatmapColors = []string{"#1f2937", "#14532d", "#166534", "#16a34a", "#22c55e"}
+
+var heatmapLevels = len(heatmapColors)
+
+// RenderHeatmapCard draws a calendar of the last 52 weeks, each day shaded by
+// the weighted score of that day's contributions rather than their count.
+func (r Renderer) RenderHeatmapCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
+	_ = ctx
+	vm := buildHeatmapViewModel(user, generatedAt, insights.Activity.Day)
+	return renderHeatmapSVG(vm, assets), nil
+}
+
+func buildHeatmapViewModel(user domain.User, generatedAt time.Time, days []domain.TimeBucket) HeatmapViewModel {
+	// 1. Pick the window: whole weeks, ending with the current one
+	last := domain.BucketStart(generatedAt, domain.GranularityDay)
+	first := domain.BucketStart(last, domain.GranularityWeek).AddDate(0, 0, -7*(heatmapWeeks-1))
+
+	byDay := make(map[time.Time]domain.TimeBucket, len(days))
+	var scores []float64
+	var total domain.Activity
+	activeDays := 0
+	for _, d := range days {
+		if d.Start.Before(first) || d.Start.After(last) {
+			continue
+		}
+		byDay[d.Start] = d
+		total.Count += d.Count
+		total.Score += d.Score
+		if d.Count > 0 {
+			activeDays++
+		}
+		if d.Score > 0 {
+			scores = append(scores, d.Score)
+		}
+	}
+	thresholds := heatmapThresholds(scores)
+
+	// 2. Build Cells and month labels
+	var cells []HeatmapCellVM
+	var months []HeatmapMonthVM
+	for day, i := first, 0; !day.After(last); day, i = day.AddDate(0, 0, 1), i+1 {
+		column, row := i/7, i%7
+		if row == 0 && (column == 0 || day.Month() != day.AddDate(0, 0, -7).Month()) {
+			months = append(months, HeatmapMonthVM{Column: column, Label: day.Format("Jan")})
+		}
+		b := byDay[day]
+		cells = append(cells, HeatmapCellVM{
+			Column:  column,
+			Row:     row,
+			Level:   heatmapLevel(b.Score, thresholds),
+			Tooltip: fmt.Sprintf("%s: %d contribution(s) · %.1f score", day.Format("Mon, Jan 2, 2006"), b.Count, b.Score),
+		})
+	}
+	// A partial first month crowds the next label
+	if len(months) > 1 && months[1].Column-months[0].Column < 3 {
+		months = months[1:]
+	}
+
+	// 3. Build Stats
+	stats := []StatVM{
+		{Label: "Impact", Value: formatLargeNum(int(total.Score)), Icon: iconStar, Raw: int(total.Score)},
+		{Label: "Contributions", Value: formatLargeNum(total.Count), Icon: iconPR, Raw: total.Count},
+		{Label: "Active Days", Value: formatCount(activeDays), Icon: iconCalendar, Raw: activeDays},
+	}
+	for i := range stats {
+		stats[i].X = i * 250
+		stats[i].Color = "#22c55e"
+	}
+
+	// 4. Size the card
+	sectionY := HeaderMargin + StatBoxSpacing
+	sectionHeight := SectionHeaderHeight + heatmapMonthRow + 7*heatmapCellPitch + heatmapLegendRow
+	height := sectionY + sectionHeight + ContentMargin + FooterHeight
+
+	return HeatmapViewModel{
+		Width:  LandscapeWidth,
+		Height: height,
+		User: UserVM{
+			Username:  user.Username,
+			AvatarKey: domain.UserAvatarKey(user.Username),
+		},
+		Stats:  stats,
+		Cells:  cells,
+		Months: months,
+		Footer: FooterVM{
+			Y:           height - 25,
+			GeneratedAt: generatedAt.Format("02 Jan 2006"),
+			Range:       "Last 52 weeks",
+			Attribution: footerAttribution,
+		},
+	}
+}
+
+// heatmapThresholds are the quartiles of the days with a score, so one
+// outstanding day doesn't wash out the rest of the year.
+func heatmapThresholds(scores []float64) []float64 {
+	if len(scores) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(scores)
+	slices.Sort(sorted)
+	thresholds := make([]float64, heatmapLevels-2)
+	for i := range thresholds {
+		thresholds[i] = sorted[(i+1)*(len(sorted)-1)/(heatmapLevels-1)]
+	}
+	return thresholds
+}
+
+// heatmapLevel is 0 for no score, otherwise 1 plus the quartiles score is
+// above.
+func heatmapLevel(score float64, thresholds []float64) int {
+	if score <= 0 {
+		return 0
+	}
+	level := 1
+	for _, t := range thresholds {
+		if score > t {
+			level++
+		}
+	}
+	return level
+}
+
+func renderHeatmapSVG(vm HeatmapViewModel, assetsMap map[domain.AssetKey]string) []byte {
+	var statBoxes []string
+	for _, s := range vm.Stats {
+		statBoxes = append(statBoxes, renderStatBox(s.X, s.Y, s.Label, s.Value, s.Icon, s.Color))
+	}
+
+	gridX := heatmapLabelWidth
+	gridY := SectionHeaderHeight + heatmapMonthRow
+
+	var grid strings.Builder
+	for _, m := range vm.Months {
+		fmt.Fprintf(&grid, `
+    <text x="%d" y="%d" font-family="system-ui, -apple-system, sans-serif" font-size="10" fill="#9ca3af">%s</text>`,
+			gridX+m.Column*heatmapCellPitch, gridY-6, m.Label)
+	}
+	for row, label := range []string{"Mon", "", "Wed", "", "Fri", "", ""} {
+		if label == "" {
+			continue
+		}
+		fmt.Fprintf(&grid, `
+    <text x="0" y="%d" font-family="system-ui, -apple-system, sans-serif" font-size="10" fill="#9ca3af">%s</text>`,
+			gridY+row*heatmapCellPitch+heatmapCellSize-1, label)
+	}
+	for _, c := range vm.Cells {
+		fmt.Fprintf(&grid, `
+    <rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"><title>%s</title></rect>`,
+			gridX+c.Column*heatmapCellPitch, gridY+c.Row*heatmapCellPitch, heatmapCellSize, heatmapCellSize,
+			heatmapColors[c.Level], html.EscapeString(c.Tooltip))
+	}
+
+	// Legend, right-aligned under the grid
+	legendY := gridY + 7*heatmapCellPitch + 10
+	legendX := FullSectionWidth - heatmapLevels*heatmapCellPitch - 30
+	fmt.Fprintf(&grid, `
+    <text x="%d" y="%d" text-anchor="end" font-family="system-ui, -apple-system, sans-serif" font-size="10" fill="#9ca3af">Less</text>`,
+		legendX-6, legendY+heatmapCellSize-1)
+	for i, color := range heatmapColors {
+		fmt.Fprintf(&grid, `
+    <rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"/>`,
+			legendX+i*heatmapCellPitch, legendY, heatmapCellSize, heatmapCellSize, color)
+	}
+	fmt.Fprintf(&grid, `
+    <text x="%d" y="%d" font-family="system-ui, -apple-system, sans-serif" font-size="10" fill="#9ca3af">More</text>`,
+		legendX+heatmapLevels*heatmapCellPitch+3, legendY+heatmapCellSize-1)
+
+	svg := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<svg width="%d" height="%d" viewBox="0 0 %d %d" fill="none" xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink">
+  %s
+
+  <!-- Background -->
+  <rect width="%d" height="%d" rx="16" fill="#1a1a1a" />
+
+  <!-- Header -->
+  %s
+
+  <g transform="translate(40, %d)">
+    %s
+  </g>
+
+  <g transform="translate(40, %d)">
+    %s
+    %s
+  </g>
+  %s
+</svg>
+`,
+		vm.Width, vm.Height, vm.Width, vm.Height,
+		renderDefs(),
+		vm.Width, vm.Height,
+		renderHeader(vm.User, assetsMap[vm.User.AvatarKey]),
+		HeaderMargin, strings.Join(statBoxes, "\n    "),
+		HeaderMargin+StatBoxSpacing, renderSectionHeader("IMPACT OVER THE LAST 52 WEEKS"), grid.String(),
+		renderFooter(vm.Footer, vm.Width),
+	)
+
+	return []byte(svg)
+}
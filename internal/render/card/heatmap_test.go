@@ -0,0 +1,103 @@
+package card
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func heatmapDay(s string, count int, score float64) domain.TimeBucket {
+	start, _ := time.Parse("2006-01-02", s)
+	return domain.TimeBucket{Start: start, Activity: domain.Activity{Count: count, Score: score}}
+}
+
+func TestBuildHeatmapViewModel_CoversFiftyTwoWeeks(t *testing.T) {
+	// A Wednesday
+	generatedAt := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+
+	vm := buildHeatmapViewModel(domain.User{Username: "ray"}, generatedAt, nil)
+
+	// 51 full weeks plus Monday to Wednesday of the current one
+	if want := 51*7 + 3; len(vm.Cells) != want {
+		t.Fatalf("expected %d cells, got %d", want, len(vm.Cells))
+	}
+	first, last := vm.Cells[0], vm.Cells[len(vm.Cells)-1]
+	if first.Column != 0 || first.Row != 0 {
+		t.Errorf("expected the first cell to be Monday of column 0, got %+v", first)
+	}
+	if last.Column != heatmapWeeks-1 || last.Row != 2 {
+		t.Errorf("expected the last cell to be Wednesday of column %d, got %+v", heatmapWeeks-1, last)
+	}
+	if !strings.HasPrefix(first.Tooltip, "Mon, Mar 10, 2025:") {
+		t.Errorf("expected the window to start on Mon, Mar 10, 2025, got %q", first.Tooltip)
+	}
+	if vm.Width > LandscapeWidth || heatmapLabelWidth+heatmapWeeks*heatmapCellPitch > FullSectionWidth {
+		t.Error("expected the grid to fit the section width")
+	}
+}
+
+func TestBuildHeatmapViewModel_ShadesByScoreNotCount(t *testing.T) {
+	generatedAt := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	days := []domain.TimeBucket{
+		heatmapDay("2026-03-02", 10, 1), // Many trivial contributions
+		heatmapDay("2026-03-03", 1, 40), // One high-impact contribution
+		heatmapDay("2026-03-04", 2, 0),  // Only owned-repo activity
+		heatmapDay("2024-01-01", 5, 99), // Outside the window
+	}
+
+	vm := buildHeatmapViewModel(domain.User{Username: "ray"}, generatedAt, days)
+
+	tail := vm.Cells[len(vm.Cells)-3:]
+	if tail[0].Level >= tail[1].Level {
+		t.Errorf("expected the higher score to be darker, got levels %d and %d", tail[0].Level, tail[1].Level)
+	}
+	if tail[1].Level != heatmapLevels-1 {
+		t.Errorf("expected the top score at level %d, got %d", heatmapLevels-1, tail[1].Level)
+	}
+	if tail[2].Level != 0 {
+		t.Errorf("expected a day without score at level 0, got %d", tail[2].Level)
+	}
+	if want := "Tue, Mar 3, 2026: 1 contribution(s) · 40.0 score"; tail[1].Tooltip != want {
+		t.Errorf("expected tooltip %q, got %q", want, tail[1].Tooltip)
+	}
+
+	if vm.Stats[0].Raw != 41 || vm.Stats[1].Raw != 13 || vm.Stats[2].Raw != 3 {
+		t.Errorf("expected impact 41, 13 contributions and 3 active days, got %+v", vm.Stats)
+	}
+}
+
+func TestHeatmapLevel_UsesQuartiles(t *testing.T) {
+	thresholds := heatmapThresholds([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	cases := map[float64]int{0: 0, 1: 1, 3: 1, 4: 2, 6: 3, 9: 4}
+	for score, want := range cases {
+		if got := heatmapLevel(score, thresholds); got != want {
+			t.Errorf("score %.0f: expected level %d, got %d", score, want, got)
+		}
+	}
+}
+
+func TestRenderHeatmapCard(t *testing.T) {
+	generatedAt := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	insights := domain.Insights{Activity: domain.TimeSeries{Day: []domain.TimeBucket{heatmapDay("2026-03-03", 1, 40)}}}
+
+	out, err := Renderer{}.RenderHeatmapCard(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, generatedAt, nil, nil, insights, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	svg := string(out)
+	for _, want := range []string{
+		"IMPACT OVER THE LAST 52 WEEKS",
+		"<title>Tue, Mar 3, 2026: 1 contribution(s) · 40.0 score</title>",
+		">Mar<", ">Mon<", ">Less<", ">More<",
+		"Last 52 weeks",
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected heatmap card to contain %q", want)
+		}
+	}
+}
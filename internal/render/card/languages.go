@@ -0,0 +1,227 @@
+package card
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+const (
+	languageSectionLimit = 6 // Legend entries on the extended cards, "Other" included
+	languageCardLimit    = 8 // Legend entries on the language card, "Other" included
+	languageLegendCols   = 3
+	languageLegendRow    = 20
+	languageCardRow      = 24
+	donutRadius          = 65
+	donutStroke          = 24
+)
+
+// languageOtherColor marks the "Other" slice, and languages linguist has no
+// color for.
+const languageOtherColor = "#6b7280"
+
+// RenderLanguageCard draws a donut of the weighted score by each repo's
+// primary language, with a legend of shares and scores.
+func (r Renderer) RenderLanguageCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
+	_ = ctx
+	vm := buildLanguageCardViewModel(user, generatedAt, insights.Languages)
+	vm.Footer.Range = r.Window.String()
+	return renderLanguageSVG(vm, assets), nil
+}
+
+// buildLanguageVMs keeps the limit-1 largest languages and folds the rest into
+// "Other", so the shares shown still add up to 100%.
+func buildLanguageVMs(shares []domain.LanguageShare, limit int) []LanguageVM {
+	kept := shares
+	var other *domain.LanguageShare
+	if len(shares) > limit {
+		kept = shares[:limit-1]
+		other = &domain.LanguageShare{Language: "Other", Color: languageOtherColor}
+		for _, s := range shares[limit-1:] {
+			other.Score += s.Score
+			other.Share += s.Share
+			other.Repos += s.Repos
+		}
+	}
+
+	var vms []LanguageVM
+	for _, s := range kept {
+		vms = append(vms, languageVM(s))
+	}
+	if other != nil {
+		vms = append(vms, languageVM(*other))
+	}
+	return vms
+}
+
+func languageVM(s domain.LanguageShare) LanguageVM {
+	color := s.Color
+	if color == "" {
+		color = languageOtherColor
+	}
+	return LanguageVM{
+		Name:    s.Language,
+		Color:   color,
+		Share:   s.Share,
+		Percent: fmt.Sprintf("%.1f%%", s.Share*100),
+		Score:   fmt.Sprintf("%.1f", s.Score),
+		Repos:   s.Repos,
+	}
+}
+
+// languageLegendRows is how many section rows the bar and its legend take:
+// one per legend line, which leaves room for the bar above the first.
+func languageLegendRows(n int) int {
+	return (n + languageLegendCols - 1) / languageLegendCols
+}
+
+func languageTooltip(l LanguageVM) string {
+	return fmt.Sprintf("%s: %s · %s score across %d repo(s)", l.Name, l.Percent, l.Score, l.Repos)
+}
+
+// renderLanguageBar draws a section's languages as one stacked bar, with a
+// legend of percentages below it.
+func renderLanguageBar(languages []LanguageVM, width int) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, `
+    <clipPath id="language-bar-clip">
+      <rect y="35" width="%d" height="10" rx="5"/>
+    </clipPath>
+    <g clip-path="url(#language-bar-clip)">`, width)
+	x := 0.0
+	for _, l := range languages {
+		w := l.Share * float64(width)
+		fmt.Fprintf(&sb, `
+      <rect x="%.2f" y="35" width="%.2f" height="10" fill="%s"><title>%s</title></rect>`,
+			x, w, l.Color, html.EscapeString(languageTooltip(l)))
+		x += w
+	}
+	sb.WriteString(`
+    </g>`)
+
+	colWidth := width / languageLegendCols
+	for i, l := range languages {
+		lx := (i % languageLegendCols) * colWidth
+		ly := 68 + (i/languageLegendCols)*languageLegendRow
+		fmt.Fprintf(&sb, `
+    <g transform="translate(%d, %d)">
+      <circle cx="5" cy="-4" r="5" fill="%s"/>
+      <text x="16" y="0" font-family="system-ui, -apple-system, sans-serif" font-size="12" font-weight="600" fill="white">%s <tspan font-weight="400" fill="#9ca3af">%s</tspan></text>
+    </g>`, lx, ly, l.Color, html.EscapeString(truncate(l.Name, 18)), l.Percent)
+	}
+	return sb.String()
+}
+
+func buildLanguageCardViewModel(user domain.User, generatedAt time.Time, shares []domain.LanguageShare) LanguageCardViewModel {
+	languages := buildLanguageVMs(shares, languageCardLimit)
+
+	contentHeight := EmptyStateHeight
+	if len(languages) > 0 {
+		contentHeight = SectionHeaderHeight + max(2*(donutRadius+donutStroke/2)+20, len(languages)*languageCardRow+20)
+	}
+	height := HeaderMargin + contentHeight + ContentMargin + FooterHeight
+
+	return LanguageCardViewModel{
+		Width:  LandscapeWidth,
+		Height: height,
+		User: UserVM{
+			Username:  user.Username,
+			AvatarKey: domain.UserAvatarKey(user.Username),
+		},
+		Languages: languages,
+		Footer: FooterVM{
+			Y:           height - 25,
+			GeneratedAt: generatedAt.Format("02 Jan 2006"),
+			Attribution: footerAttribution,
+		},
+	}
+}
+
+func renderLanguageSVG(vm LanguageCardViewModel, assetsMap map[domain.AssetKey]string) []byte {
+	var body string
+	if len(vm.Languages) == 0 {
+		body = renderEmptyState("No language data yet")
+	} else {
+		body = renderDonut(vm.Languages) + renderLanguageLegend(vm.Languages)
+	}
+
+	svg := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<svg width="%d" height="%d" viewBox="0 0 %d %d" fill="none" xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink">
+  %s
+
+  <!-- Background -->
+  <rect width="%d" height="%d" rx="16" fill="#1a1a1a" />
+
+  <!-- Header -->
+  %s
+
+  <g transform="translate(40, %d)">
+    %s
+    %s
+  </g>
+  %s
+</svg>
+`,
+		vm.Width, vm.Height, vm.Width, vm.Height,
+		renderDefs(),
+		vm.Width, vm.Height,
+		renderHeader(vm.User, assetsMap[vm.User.AvatarKey]),
+		HeaderMargin, renderSectionHeader("IMPACT BY LANGUAGE"), body,
+		renderFooter(vm.Footer, vm.Width),
+	)
+
+	return []byte(svg)
+}
+
+// renderDonut draws each language as an arc of one stroked circle, starting
+// at twelve o'clock and running clockwise.
+func renderDonut(languages []LanguageVM) string {
+	cx := donutRadius + donutStroke/2
+	cy := SectionHeaderHeight + 10 + cx
+	circumference := 2 * math.Pi * donutRadius
+
+	var sb strings.Builder
+	offset := 0.0
+	for _, l := range languages {
+		arc := l.Share * circumference
+		fmt.Fprintf(&sb, `
+    <circle cx="%d" cy="%d" r="%d" stroke="%s" stroke-width="%d" stroke-dasharray="%.2f %.2f" stroke-dashoffset="%.2f" transform="rotate(-90 %d %d)"><title>%s</title></circle>`,
+			cx, cy, donutRadius, l.Color, donutStroke, arc, circumference-arc, -offset, cx, cy,
+			html.EscapeString(languageTooltip(l)))
+		offset += arc
+	}
+
+	// Top language in the hole
+	fmt.Fprintf(&sb, `
+    <text x="%d" y="%d" text-anchor="middle" font-family="system-ui, -apple-system, sans-serif" font-size="20" font-weight="700" fill="white">%s</text>
+    <text x="%d" y="%d" text-anchor="middle" font-family="system-ui, -apple-system, sans-serif" font-size="10" font-weight="600" fill="#9ca3af" letter-spacing="0.5">%s</text>`,
+		cx, cy+2, languages[0].Percent, cx, cy+18, html.EscapeString(strings.ToUpper(truncate(languages[0].Name, 12))))
+	return sb.String()
+}
+
+func renderLanguageLegend(languages []LanguageVM) string {
+	const x = 2*(donutRadius+donutStroke/2) + 50
+
+	var sb strings.Builder
+	for i, l := range languages {
+		y := SectionHeaderHeight + 20 + i*languageCardRow
+		fmt.Fprintf(&sb, `
+    <g transform="translate(%d, %d)">
+      <title>%s</title>
+      <rect y="-10" width="12" height="12" rx="3" fill="%s"/>
+      <text x="22" y="0" font-family="system-ui, -apple-system, sans-serif" font-size="13" font-weight="600" fill="white">%s</text>
+      <text x="%d" y="0" text-anchor="end" font-family="system-ui, -apple-system, sans-serif" font-size="13" font-weight="600" fill="#22c55e">%s</text>
+      <text x="%d" y="0" text-anchor="end" font-family="system-ui, -apple-system, sans-serif" font-size="11" fill="#9ca3af">%s score</text>
+    </g>`,
+			x, y, html.EscapeString(languageTooltip(l)), l.Color, html.EscapeString(truncate(l.Name, 24)),
+			FullSectionWidth-x-120, l.Percent,
+			FullSectionWidth-x, l.Score)
+	}
+	return sb.String()
+}
@@ -0,0 +1,110 @@
+package card
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+var testLanguages = []domain.LanguageShare{
+	{Language: "Go", Color: "#00ADD8", Score: 60, Share: 0.6, Repos: 3},
+	{Language: "Rust", Color: "#dea584", Score: 25, Share: 0.25, Repos: 1},
+	{Language: "Shell", Color: "#89e051", Score: 10, Share: 0.1, Repos: 2},
+	{Language: "Nix", Score: 5, Share: 0.05, Repos: 1}, // No linguist color
+}
+
+func TestBuildLanguageVMs_FoldsTailIntoOther(t *testing.T) {
+	vms := buildLanguageVMs(testLanguages, 3)
+
+	if len(vms) != 3 {
+		t.Fatalf("expected 2 languages and Other, got %+v", vms)
+	}
+	other := vms[2]
+	if other.Name != "Other" || other.Color != languageOtherColor || other.Repos != 3 || other.Score != "15.0" {
+		t.Errorf("expected Shell and Nix folded into Other, got %+v", other)
+	}
+	var total float64
+	for _, l := range vms {
+		total += l.Share
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("expected shares to add up to 1, got %v", total)
+	}
+	if vms[0].Percent != "60.0%" {
+		t.Errorf("expected 60.0%%, got %q", vms[0].Percent)
+	}
+
+	all := buildLanguageVMs(testLanguages, 8)
+	if len(all) != 4 || all[3].Color != languageOtherColor {
+		t.Errorf("expected every language kept, uncolored ones in gray, got %+v", all)
+	}
+}
+
+func TestRenderExtendedCard_LanguagesSection(t *testing.T) {
+	user := domain.User{Username: "ray"}
+	stats := domain.StatsView{PRsOpened: 1}
+	insights := domain.Insights{Languages: testLanguages}
+
+	out, err := Renderer{}.RenderExtendedCard(context.Background(), user, stats, time.Now(), nil, nil, insights, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if strings.Contains(string(out), "IMPACT BY LANGUAGE") {
+		t.Error("expected languages section to be opt-in")
+	}
+
+	without := buildViewModel(user, stats, time.Now(), nil, nil, insights, Renderer{}.options(true, true, false))
+	with := buildViewModel(user, stats, time.Now(), nil, nil, insights, Renderer{ShowLanguages: true}.options(true, true, false))
+	if len(with.Layout.Sections) != len(without.Layout.Sections)+1 || with.Height <= without.Height {
+		t.Error("expected the languages section to be laid out below the others")
+	}
+
+	out, err = Renderer{ShowLanguages: true}.RenderExtendedCard(context.Background(), user, stats, time.Now(), nil, nil, insights, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	svg := string(out)
+	for _, want := range []string{"IMPACT BY LANGUAGE", `fill="#00ADD8"`, ">Go <", "60.0%", "<title>Rust: 25.0% · 25.0 score across 1 repo(s)</title>"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected extended card to contain %q", want)
+		}
+	}
+}
+
+func TestRenderExtendedMinimalCard_HidesEmptyLanguages(t *testing.T) {
+	user := domain.User{Username: "ray"}
+	out, err := Renderer{ShowLanguages: true}.RenderExtendedMinimalCard(context.Background(), user, domain.StatsView{PRsOpened: 1}, time.Now(), nil, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if strings.Contains(string(out), "IMPACT BY LANGUAGE") {
+		t.Error("expected empty languages section to be hidden on the minimal variant")
+	}
+}
+
+func TestRenderLanguageCard(t *testing.T) {
+	user := domain.User{Username: "ray"}
+
+	out, err := Renderer{}.RenderLanguageCard(context.Background(), user, domain.StatsView{}, time.Now(), nil, nil, domain.Insights{Languages: testLanguages}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	svg := string(out)
+	for _, want := range []string{"IMPACT BY LANGUAGE", `stroke="#dea584"`, ">Shell<", ">10.0%<", "60.0 score", ">GO<"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected language card to contain %q", want)
+		}
+	}
+
+	out, err = Renderer{}.RenderLanguageCard(context.Background(), user, domain.StatsView{}, time.Now(), nil, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if !strings.Contains(string(out), "No language data yet") {
+		t.Error("expected an empty state without languages")
+	}
+}
@@ -44,6 +44,7 @@ const (
 	VerticalWidth        = 500
 	SectionWidth         = 340
 	VerticalSectionWidth = 420
+	FullSectionWidth     = LandscapeWidth - 80
 )
 
 // DecideLayout determines the card's structural properties based on input content.
@@ -113,9 +114,11 @@ func DecideLayout(input LayoutInput) LayoutVM {
 
 		xPos := 40 // Default X
 		yPos := currentY
+		sectionWidth := SectionWidth
 
 		// If the entire card is in vertical mode, we ignore horizontal placement intent
 		if isVertical {
+			sectionWidth = VerticalSectionWidth
 			currentY += h
 			if s.IsEmpty {
 				currentY += EmptyStatePadding
@@ -129,14 +132,20 @@ func DecideLayout(input LayoutInput) LayoutVM {
 				maxRowH = h
 			}
 		} else {
-			// StackVertical in a non-vertical card (full width stack)
+			// StackVertical in a non-vertical card (full width stack).
+			// Close any open horizontal row first so the sections don't overlap.
+			currentY += maxRowH
+			maxRowH = 0
+			yPos = currentY
+			sectionWidth = FullSectionWidth
+
 			currentY += h
 			if s.IsEmpty {
 				currentY += EmptyStatePadding
 			}
 		}
 
-		sectionLayouts = append(sectionLayouts, SectionLayoutVM{X: xPos, Y: yPos})
+		sectionLayouts = append(sectionLayouts, SectionLayoutVM{X: xPos, Y: yPos, Width: sectionWidth})
 	}
 
 	// Add max height from horizontal sections if any
@@ -146,3 +146,34 @@ func TestDecideLayout_HorizontalSections_DoNotOverlap(t *testing.T) {
 		)
 	}
 }
+
+func TestDecideLayout_VerticalSectionStartsBelowHorizontalRow(t *testing.T) {
+	input := LayoutInput{
+		StatCount:    6,
+		ShowAllStats: true,
+		Mode:         LayoutHorizontal,
+		Sections: []SectionLayoutInput{
+			{Rows: 3, Placement: StackHorizontal, Column: 0},
+			{Rows: 1, Placement: StackHorizontal, Column: 1},
+			{Rows: 2, Placement: StackVertical},
+		},
+	}
+
+	layout := DecideLayout(input)
+	row := layout.Sections[0]
+	full := layout.Sections[2]
+
+	tallest := SectionHeaderHeight + 3*layout.RowHeight
+	if full.Y < row.Y+tallest {
+		t.Errorf("expected full-width section below the horizontal row (y >= %d), got %d", row.Y+tallest, full.Y)
+	}
+	if full.Width != FullSectionWidth {
+		t.Errorf("expected full-width section width %d, got %d", FullSectionWidth, full.Width)
+	}
+	if row.Width != SectionWidth {
+		t.Errorf("expected column width %d, got %d", SectionWidth, row.Width)
+	}
+	if layout.Height < full.Y+SectionHeaderHeight+2*layout.RowHeight+FooterHeight {
+		t.Errorf("expected card height to include the full-width section, got %d", layout.Height)
+	}
+}
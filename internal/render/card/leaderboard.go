@@ -0,0 +1,198 @@
+package card
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// DefaultLeaderboardSize is the number of members shown when
+// Renderer.LeaderboardSize is unset.
+const DefaultLeaderboardSize = 10
+
+const (
+	leaderboardBadgeX    = 262
+	leaderboardBarX      = 330
+	leaderboardBarWidth  = 280
+	leaderboardUpstreams = 2
+)
+
+// RenderLeaderboard ranks the top members of an org footprint by score.
+func (r Renderer) RenderLeaderboard(ctx context.Context, org domain.OrgFootprint, generatedAt time.Time, assets map[domain.AssetKey]string) ([]byte, error) {
+	_ = ctx
+	size := r.LeaderboardSize
+	if size <= 0 {
+		size = DefaultLeaderboardSize
+	}
+	vm := buildLeaderboardViewModel(org, generatedAt, size)
+	vm.Footer.Range = r.Window.String()
+	return renderLeaderboardSVG(vm, assets), nil
+}
+
+func buildLeaderboardViewModel(org domain.OrgFootprint, generatedAt time.Time, size int) LeaderboardViewModel {
+	// 1. Build Stats
+	stats := []StatVM{
+		{Label: "Members", Value: formatCount(len(org.Members)), Icon: iconPeople, Raw: len(org.Members)},
+		{Label: "Total Impact", Value: formatLargeNum(int(org.TotalScore)), Icon: iconStar, Raw: int(org.TotalScore)},
+		{Label: "PRs Opened", Value: formatLargeNum(org.Stats.PRsOpened), Icon: iconPR, Raw: org.Stats.PRsOpened},
+	}
+	for i := range stats {
+		stats[i].X = i * 250
+		stats[i].Color = "#22c55e"
+	}
+
+	// 2. Build Rows. Members arrive sorted by score.
+	top := org.Members[:min(size, len(org.Members))]
+	maxScore := 0.0
+	if len(top) > 0 {
+		maxScore = top[0].Score
+	}
+
+	rows := make([]LeaderboardRowVM, 0, len(top))
+	for i, m := range top {
+		username := m.User.Username
+
+		var upstreams []string
+		for _, r := range m.Repos[:min(leaderboardUpstreams, len(m.Repos))] {
+			upstreams = append(upstreams, r.Repo)
+		}
+
+		barWidth := 0
+		if maxScore > 0 {
+			// A negative score (or a negative top score) mustn't draw outside the track
+			barWidth = min(max(int(m.Score/maxScore*leaderboardBarWidth), 0), leaderboardBarWidth)
+		}
+
+		rows = append(rows, LeaderboardRowVM{
+			Rank:      i + 1,
+			Username:  truncate(username, 20),
+			Link:      "https://github.com/" + username,
+			AvatarKey: domain.UserAvatarKey(username),
+			Upstreams: truncate(strings.Join(upstreams, " · "), 32),
+			RepoCount: len(m.Repos),
+			Score:     fmt.Sprintf("%.1f", m.Score),
+			BarWidth:  barWidth,
+		})
+	}
+
+	// 3. Size the card
+	sectionY := HeaderMargin + StatBoxSpacing
+	sectionHeight := SectionHeaderHeight + len(rows)*SectionRowHeight
+	if len(rows) == 0 {
+		sectionHeight = EmptyStateHeight
+	}
+	height := sectionY + sectionHeight + ContentMargin + FooterHeight
+
+	return LeaderboardViewModel{
+		Width:  LandscapeWidth,
+		Height: height,
+		User: UserVM{
+			Username:  org.Scope.Handle(),
+			AvatarKey: domain.UserAvatarKey(org.Scope.Handle()),
+		},
+		Stats: stats,
+		Rows:  rows,
+		Footer: FooterVM{
+			Y:           height - 25,
+			GeneratedAt: generatedAt.Format("02 Jan 2006"),
+			Attribution: footerAttribution,
+		},
+	}
+}
+
+func renderLeaderboardSVG(vm LeaderboardViewModel, assetsMap map[domain.AssetKey]string) []byte {
+	var statBoxes []string
+	for _, s := range vm.Stats {
+		statBoxes = append(statBoxes, renderStatBox(s.X, s.Y, s.Label, s.Value, s.Icon, s.Color))
+	}
+
+	var rows strings.Builder
+	if len(vm.Rows) == 0 {
+		rows.WriteString(renderEmptyState("No members found"))
+	}
+	for i, row := range vm.Rows {
+		rows.WriteString(renderLeaderboardRow(row, 35+i*SectionRowHeight, assetsMap[row.AvatarKey]))
+	}
+
+	svg := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<svg width="%d" height="%d" viewBox="0 0 %d %d" fill="none" xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink">
+  %s
+
+  <!-- Background -->
+  <rect width="%d" height="%d" rx="16" fill="#1a1a1a" />
+
+  <!-- Header -->
+  %s
+
+  <g transform="translate(40, %d)">
+    %s
+  </g>
+
+  <g transform="translate(40, %d)">
+    %s
+    %s
+  </g>
+  %s
+</svg>
+`,
+		vm.Width, vm.Height, vm.Width, vm.Height,
+		renderDefs(),
+		vm.Width, vm.Height,
+		renderHeader(vm.User, assetsMap[vm.User.AvatarKey]),
+		HeaderMargin, strings.Join(statBoxes, "\n    "),
+		HeaderMargin+StatBoxSpacing, renderSectionHeader("LEADERBOARD"), rows.String(),
+		renderFooter(vm.Footer, vm.Width),
+	)
+
+	return []byte(svg)
+}
+
+func renderLeaderboardRow(row LeaderboardRowVM, y int, avatar string) string {
+	titleY := 28
+	subtitleSVG := ""
+	if row.Upstreams != "" {
+		titleY = 18
+		subtitleSVG = fmt.Sprintf(`<text x="74" y="32" font-family="system-ui, -apple-system, sans-serif" font-size="10" fill="#9ca3af">%s</text>`, html.EscapeString(row.Upstreams))
+	}
+	badgeSVG := ""
+	if row.RepoCount > 0 {
+		badgeSVG = fmt.Sprintf(`<g transform="translate(%d, 10.5)">
+          <title>%d upstream repo(s)</title>%s
+          <text x="30" y="16.5" font-family="system-ui, -apple-system, sans-serif" font-size="12" font-weight="600" fill="#22c55e">%d</text>
+        </g>`, leaderboardBadgeX, row.RepoCount, renderSmallIconBox(iconProject), row.RepoCount)
+	}
+
+	return fmt.Sprintf(`
+    <a xlink:href="%s" target="_blank">
+      <g transform="translate(0, %d)">
+        <rect width="%d" height="45" rx="10" fill="#1f2937" opacity="0.3" stroke="#374151" stroke-width="1"/>
+        <text x="22" y="28" text-anchor="middle" font-family="system-ui, -apple-system, sans-serif" font-size="14" font-weight="700" fill="#22c55e">%d</text>
+        <g transform="translate(40, 10.5)">
+          <image href="%s" width="24" height="24" clip-path="url(#repo-clip)" x="0" y="0"/>
+        </g>
+        <text x="74" y="%d" font-family="system-ui, -apple-system, sans-serif" font-size="13" font-weight="600" fill="white">%s</text>
+        %s
+        %s
+        <rect x="%d" y="18.5" width="%d" height="8" rx="4" fill="#374151"/>
+        <rect x="%d" y="18.5" width="%d" height="8" rx="4" fill="#22c55e"/>
+        <text x="%d" y="27" text-anchor="end" font-family="system-ui, -apple-system, sans-serif" font-size="13" font-weight="700" fill="white">%s</text>
+      </g>
+    </a>`,
+		html.EscapeString(row.Link),
+		y,
+		FullSectionWidth,
+		row.Rank,
+		avatar,
+		titleY,
+		html.EscapeString(row.Username),
+		subtitleSVG,
+		badgeSVG,
+		leaderboardBarX, leaderboardBarWidth,
+		leaderboardBarX, row.BarWidth,
+		FullSectionWidth-10, row.Score,
+	)
+}
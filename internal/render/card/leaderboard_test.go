@@ -0,0 +1,82 @@
+package card
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestRenderLeaderboard_RanksTopMembers(t *testing.T) {
+	org := domain.OrgFootprint{
+		Scope:      domain.OrgScope{Org: "acme", Name: "acme"},
+		TotalScore: 130,
+		Members: []domain.MemberFootprint{
+			{User: domain.User{Username: "ana"}, Score: 100, Repos: []domain.RepoContribution{{Repo: "up/stream"}, {Repo: "other/lib"}, {Repo: "third/tool"}}},
+			{User: domain.User{Username: "bo"}, Score: 25},
+			{User: domain.User{Username: "cy"}, Score: 5},
+		},
+	}
+
+	out, err := Renderer{LeaderboardSize: 2}.RenderLeaderboard(context.Background(), org, time.Now(), nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	svg := string(out)
+	for _, want := range []string{"LEADERBOARD", ">ana<", ">bo<", "up/stream · other/lib", ">100.0<", "TOTAL IMPACT"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected leaderboard to contain %q", want)
+		}
+	}
+	if strings.Contains(svg, ">cy<") {
+		t.Error("expected members beyond the leaderboard size to be omitted")
+	}
+	if !strings.Contains(svg, "3 upstream repo(s)") {
+		t.Error("expected an upstream badge with the member's repo count")
+	}
+	if strings.Contains(svg, "third/tool") {
+		t.Error("expected only the main upstreams to be listed")
+	}
+}
+
+func TestBuildLeaderboardViewModel_ScalesBarsToTopMember(t *testing.T) {
+	org := domain.OrgFootprint{
+		Members: []domain.MemberFootprint{
+			{User: domain.User{Username: "ana"}, Score: 80},
+			{User: domain.User{Username: "bo"}, Score: 20},
+		},
+	}
+
+	vm := buildLeaderboardViewModel(org, time.Now(), DefaultLeaderboardSize)
+
+	if len(vm.Rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(vm.Rows))
+	}
+	if vm.Rows[0].BarWidth != leaderboardBarWidth || vm.Rows[1].BarWidth != leaderboardBarWidth/4 {
+		t.Errorf("expected bar widths %d and %d, got %d and %d", leaderboardBarWidth, leaderboardBarWidth/4, vm.Rows[0].BarWidth, vm.Rows[1].BarWidth)
+	}
+	if vm.Rows[1].Rank != 2 {
+		t.Errorf("expected rank 2, got %d", vm.Rows[1].Rank)
+	}
+}
+
+func TestBuildLeaderboardViewModel_ClampsBarWidth(t *testing.T) {
+	org := domain.OrgFootprint{
+		Members: []domain.MemberFootprint{
+			{User: domain.User{Username: "ana"}, Score: 40},
+			{User: domain.User{Username: "bo"}, Score: -10},
+		},
+	}
+
+	vm := buildLeaderboardViewModel(org, time.Now(), DefaultLeaderboardSize)
+
+	if vm.Rows[1].BarWidth != 0 {
+		t.Errorf("expected a negative score to draw an empty bar, got width %d", vm.Rows[1].BarWidth)
+	}
+	if vm.Rows[0].BarWidth != leaderboardBarWidth {
+		t.Errorf("expected the top member to fill the bar, got width %d", vm.Rows[0].BarWidth)
+	}
+}
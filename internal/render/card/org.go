@@ -0,0 +1,121 @@
+package card
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func (r Renderer) RenderOrgCard(ctx context.Context, org domain.OrgFootprint, generatedAt time.Time, assets map[domain.AssetKey]string) ([]byte, error) {
+	_ = ctx
+	vm := buildOrgViewModel(org, generatedAt)
+	vm.Footer.Range = r.Window.String()
+	return renderSVG(vm, assets), nil
+}
+
+func buildOrgViewModel(org domain.OrgFootprint, generatedAt time.Time) CardViewModel {
+	stats := org.Stats
+	codeReview := stats.PRReviewComments + stats.PRReviews
+
+	// 1. Build Stats. Always show the full grid so org cards line up.
+	activeStats := []StatVM{
+		{Label: "Members", Value: formatCount(len(org.Members)), Icon: iconPeople, Raw: len(org.Members)},
+		{Label: "Upstream Repos", Value: formatCount(len(org.Upstreams)), Icon: iconProject, Raw: len(org.Upstreams)},
+		{Label: "PRs Opened", Value: formatLargeNum(stats.PRsOpened), Icon: iconPR, Raw: stats.PRsOpened},
+		{Label: "Code Reviews", Value: formatLargeNum(codeReview), Icon: iconReview, Raw: codeReview},
+		{Label: "Issues Opened", Value: formatLargeNum(stats.IssuesOpened), Icon: iconIssue, Raw: stats.IssuesOpened},
+		{Label: "Issue Comments", Value: formatLargeNum(stats.IssueComments), Icon: iconComment, Raw: stats.IssueComments},
+	}
+
+	topUpstreams := org.Upstreams
+	if len(topUpstreams) > 3 {
+		topUpstreams = topUpstreams[:3]
+	}
+	topMembers := org.Members
+	if len(topMembers) > 3 {
+		topMembers = topMembers[:3]
+	}
+
+	// 2. Decide Layout
+	layout := DecideLayout(LayoutInput{
+		StatCount:    len(activeStats),
+		ShowAllStats: true,
+		Mode:         LayoutHorizontal,
+		Sections: []SectionLayoutInput{
+			{Rows: len(topUpstreams), IsEmpty: len(topUpstreams) == 0, Placement: StackHorizontal, Column: 0},
+			{Rows: len(topMembers), IsEmpty: len(topMembers) == 0, Placement: StackHorizontal, Column: 1},
+		},
+	})
+
+	// 3. Position Stats
+	for i := range activeStats {
+		activeStats[i].X = (i % 3) * 250
+		activeStats[i].Y = (i / 3) * 90
+		activeStats[i].Color = "#22c55e"
+	}
+
+	// 4. Build Sections
+	var upstreamRows []SectionRowVM
+	for _, u := range topUpstreams {
+		owner, name, _ := strings.Cut(u.Repo, "/")
+		badges := []BadgeVM{}
+		if u.PRsOpened > 0 {
+			badges = append(badges, BadgeVM{Count: fmt.Sprintf("%d", u.PRsOpened), Icon: iconPR, Link: u.RepoURL + "/pulls"})
+		}
+		badges = append(badges, BadgeVM{Count: fmt.Sprintf("%d", len(u.Members)), Icon: iconPeople, Link: u.RepoURL})
+		upstreamRows = append(upstreamRows, SectionRowVM{
+			Kind:      RowExternalContribution,
+			Title:     truncate(name, 15),
+			Subtitle:  truncate(owner, 20),
+			Link:      u.RepoURL,
+			AvatarKey: domain.RepoAvatarKey(u.Repo),
+			Badges:    badges,
+		})
+	}
+
+	var memberRows []SectionRowVM
+	for _, m := range topMembers {
+		username := m.User.Username
+		badges := []BadgeVM{}
+		if m.Stats.PRsOpened > 0 {
+			link := fmt.Sprintf("https://github.com/pulls?q=is%%3Apr+author%%3A%s+-user%%3A%s", username, username)
+			badges = append(badges, BadgeVM{Count: fmt.Sprintf("%d", m.Stats.PRsOpened), Icon: iconPR, Link: link})
+		}
+		memberRows = append(memberRows, SectionRowVM{
+			Kind:      RowExternalContribution,
+			Title:     truncate(username, 15),
+			Subtitle:  fmt.Sprintf("%d repos", len(m.Repos)),
+			Link:      "https://github.com/" + username,
+			AvatarKey: domain.UserAvatarKey(username),
+			Badges:    badges,
+		})
+	}
+
+	sections := []SectionVM{
+		{Title: "TOP UPSTREAMS", EmptyMessage: "No upstream contributions yet", Rows: upstreamRows},
+		{Title: "TOP MEMBERS", EmptyMessage: "No members found", Rows: memberRows},
+	}
+
+	footer := FooterVM{
+		Y:           layout.Height - 25,
+		GeneratedAt: generatedAt.Format("02 Jan 2006"),
+		Attribution: footerAttribution,
+	}
+
+	return CardViewModel{
+		Width:      layout.Width,
+		Height:     layout.Height,
+		IsVertical: layout.IsVertical,
+		Layout:     layout,
+		User: UserVM{
+			Username:  org.Scope.Handle(),
+			AvatarKey: domain.UserAvatarKey(org.Scope.Handle()),
+		},
+		Stats:    activeStats,
+		Sections: sections,
+		Footer:   footer,
+	}
+}
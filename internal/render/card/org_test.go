@@ -0,0 +1,36 @@
+package card
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestRenderOrgCard_ShowsUpstreamsAndMembers(t *testing.T) {
+	org := domain.OrgFootprint{
+		Scope: domain.OrgScope{Org: "acme", Team: "platform", Name: "acme/platform"},
+		Stats: domain.StatsView{PRsOpened: 12, PRReviews: 4},
+		Members: []domain.MemberFootprint{
+			{User: domain.User{Username: "ana"}, Stats: domain.StatsView{PRsOpened: 9}, Score: 90},
+			{User: domain.User{Username: "bo"}, Stats: domain.StatsView{PRsOpened: 3}, Score: 30},
+		},
+		Upstreams: []domain.UpstreamImpact{
+			{RepoContribution: domain.RepoContribution{Repo: "up/stream", RepoURL: "https://github.com/up/stream", PRsOpened: 12}, Members: []string{"ana", "bo"}},
+		},
+	}
+
+	out, err := Renderer{}.RenderOrgCard(context.Background(), org, time.Now(), nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	svg := string(out)
+	for _, want := range []string{"TOP UPSTREAMS", "TOP MEMBERS", "MEMBERS", ">stream<", ">ana<", ">12<", "https://github.com/acme"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected org card to contain %q", want)
+		}
+	}
+}
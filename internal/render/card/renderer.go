@@ -13,42 +13,97 @@ import (
 
 type Renderer struct {
 	MinDisplayStars int
+	ShowMilestones  bool              // Adds a milestones section to the extended variants
+	ShowLanguages   bool              // Adds a score-by-language section to the extended variants
+	ShowTrend       bool              // Marks each stat with its change since the history baseline
+	LeaderboardSize int               // Members ranked on the leaderboard, DefaultLeaderboardSize if zero
+	Window          domain.TimeWindow // Period shown in the footer
+}
+
+// viewOptions selects which parts of the card buildViewModel produces.
+type viewOptions struct {
+	ShowAllStats    bool
+	ShowSections    bool
+	MinimalSections bool
+	MinDisplayStars int
+	ShowMilestones  bool
+	ShowLanguages   bool
+	ShowTrend       bool
+	Range           string
+}
+
+func (r Renderer) options(showAllStats, showSections, minimalSections bool) viewOptions {
+	return viewOptions{
+		ShowAllStats:    showAllStats,
+		ShowSections:    showSections,
+		MinimalSections: minimalSections,
+		MinDisplayStars: r.MinDisplayStars,
+		ShowMilestones:  r.ShowMilestones,
+		ShowLanguages:   r.ShowLanguages,
+		ShowTrend:       r.ShowTrend,
+		Range:           r.Window.String(),
+	}
 }
 
 // RenderCard: All stats, no sections
-func (r Renderer) RenderCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, assets map[domain.AssetKey]string) ([]byte, error) {
-	vm := buildViewModel(user, stats, generatedAt, contributions, projects, true, false, false, r.MinDisplayStars)
+func (r Renderer) RenderCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
+	vm := buildViewModel(user, stats, generatedAt, contributions, projects, insights, r.options(true, false, false))
 	return renderSVG(vm, assets), nil
 }
 
 // RenderMinimalCard: Non-zero stats only, no sections
-func (r Renderer) RenderMinimalCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, assets map[domain.AssetKey]string) ([]byte, error) {
-	vm := buildViewModel(user, stats, generatedAt, contributions, projects, false, false, false, r.MinDisplayStars)
+func (r Renderer) RenderMinimalCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
+	vm := buildViewModel(user, stats, generatedAt, contributions, projects, insights, r.options(false, false, false))
 	return renderSVG(vm, assets), nil
 }
 
 // RenderExtendedCard: All stats + both sections
-func (r Renderer) RenderExtendedCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, assets map[domain.AssetKey]string) ([]byte, error) {
-	vm := buildViewModel(user, stats, generatedAt, contributions, projects, true, true, false, r.MinDisplayStars)
+func (r Renderer) RenderExtendedCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
+	vm := buildViewModel(user, stats, generatedAt, contributions, projects, insights, r.options(true, true, false))
 	return renderSVG(vm, assets), nil
 }
 
 // RenderExtendedMinimalCard: Non-zero stats + sections only if content exists
-func (r Renderer) RenderExtendedMinimalCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, assets map[domain.AssetKey]string) ([]byte, error) {
-	vm := buildViewModel(user, stats, generatedAt, contributions, projects, false, true, true, r.MinDisplayStars)
+func (r Renderer) RenderExtendedMinimalCard(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, assets map[domain.AssetKey]string) ([]byte, error) {
+	vm := buildViewModel(user, stats, generatedAt, contributions, projects, insights, r.options(false, true, true))
 	return renderSVG(vm, assets), nil
 }
 
-func buildViewModel(user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, showAllStats bool, showSections bool, minimalSections bool, minDisplayStars int) CardViewModel {
+func buildViewModel(user domain.User, stats domain.StatsView, generatedAt time.Time, contributions []domain.RepoContribution, projects []domain.OwnedProjectImpact, insights domain.Insights, opts viewOptions) CardViewModel {
+	showAllStats := opts.ShowAllStats
+	showSections := opts.ShowSections
+	minimalSections := opts.MinimalSections
+
+	// Stat deltas stay zero, and draw no arrows, unless trends are shown
+	var delta domain.StatsView
+	if opts.ShowTrend && insights.Trend != nil {
+		delta = insights.Trend.Stats
+	}
+
 	codeReview := stats.PRReviewComments + stats.PRReviews
 	// 1. Build Stats
 	potentialStats := []StatVM{
-		{Label: "PRs Opened", Value: formatCount(stats.PRsOpened), Icon: iconPR, Raw: stats.PRsOpened},
-		{Label: "Code Reviews", Value: formatCount(codeReview), Icon: iconReview, Raw: codeReview},
-		{Label: "Issues Opened", Value: formatCount(stats.IssuesOpened), Icon: iconIssue, Raw: stats.IssuesOpened},
-		{Label: "Issue Comments", Value: formatCount(stats.IssueComments), Icon: iconComment, Raw: stats.IssueComments},
-		{Label: "Projects Owned", Value: formatCount(stats.ProjectsOwned), Icon: iconProject, Raw: stats.ProjectsOwned},
-		{Label: "Stars Earned", Value: formatLargeNum(stats.StarsEarned), Icon: iconStar, Raw: stats.StarsEarned},
+		{Label: "PRs Opened", Value: formatCount(stats.PRsOpened), Icon: iconPR, Raw: stats.PRsOpened, Delta: delta.PRsOpened},
+		{Label: "Code Reviews", Value: formatCount(codeReview), Icon: iconReview, Raw: codeReview, Delta: delta.PRReviewComments + delta.PRReviews},
+		{Label: "Issues Opened", Value: formatCount(stats.IssuesOpened), Icon: iconIssue, Raw: stats.IssuesOpened, Delta: delta.IssuesOpened},
+		{Label: "Issue Comments", Value: formatCount(stats.IssueComments), Icon: iconComment, Raw: stats.IssueComments, Delta: delta.IssueComments},
+		{Label: "Projects Owned", Value: formatCount(stats.ProjectsOwned), Icon: iconProject, Raw: stats.ProjectsOwned, Delta: delta.ProjectsOwned},
+		{Label: "Stars Earned", Value: formatLargeNum(stats.StarsEarned), Icon: iconStar, Raw: stats.StarsEarned, Delta: delta.StarsEarned},
+	}
+	if stats.SecurityAdvisories > 0 {
+		potentialStats = append(potentialStats, StatVM{Label: "Security Advisories", Value: formatCount(stats.SecurityAdvisories), Icon: iconShield, Raw: stats.SecurityAdvisories, Delta: delta.SecurityAdvisories})
+	}
+	if stats.TriageActions > 0 {
+		potentialStats = append(potentialStats, StatVM{Label: "Triage Actions", Value: formatLargeNum(stats.TriageActions), Icon: iconTriage, Raw: stats.TriageActions, Delta: delta.TriageActions})
+	}
+	// Opt-in, aggregate-only: never broken down by repository
+	if stats.Private != nil {
+		private := stats.Private.Total()
+		var privateDelta int
+		if delta.Private != nil {
+			privateDelta = delta.Private.Total()
+		}
+		potentialStats = append(potentialStats, StatVM{Label: "Private Contributions", Value: formatLargeNum(private), Icon: iconLock, Raw: private, Delta: privateDelta})
 	}
 
 	var activeStats []StatVM
@@ -63,7 +118,7 @@ func buildViewModel(user domain.User, stats domain.StatsView, generatedAt time.T
 	// Filter for display only — stats.ProjectsOwned and stats.StarsEarned are authoritative
 	var sectionProjects []domain.OwnedProjectImpact
 	for _, p := range projects {
-		if p.Stars >= minDisplayStars {
+		if p.Stars >= opts.MinDisplayStars {
 			sectionProjects = append(sectionProjects, p)
 		}
 	}
@@ -94,8 +149,20 @@ func buildViewModel(user domain.User, stats domain.StatsView, generatedAt time.T
 		topExternal = topExternal[:3]
 	}
 
+	var topMilestones []domain.Milestone
+	if opts.ShowMilestones {
+		topMilestones = selectTopMilestones(insights.Milestones, 3)
+	}
+
+	var topLanguages []LanguageVM
+	if opts.ShowLanguages {
+		topLanguages = buildLanguageVMs(insights.Languages, languageSectionLimit)
+	}
+
 	hasOwned := len(topOwned) > 0
 	hasExternal := len(topExternal) > 0
+	hasMilestones := len(topMilestones) > 0
+	hasLanguages := len(topLanguages) > 0
 
 	statCount := len(activeStats)
 
@@ -125,6 +192,20 @@ func buildViewModel(user domain.User, stats domain.StatsView, generatedAt time.T
 			})
 			colIdx++
 		}
+		if opts.ShowMilestones && (!minimalSections || hasMilestones) {
+			layoutSections = append(layoutSections, SectionLayoutInput{
+				Rows:      len(topMilestones),
+				IsEmpty:   !hasMilestones,
+				Placement: StackVertical,
+			})
+		}
+		if opts.ShowLanguages && (!minimalSections || hasLanguages) {
+			layoutSections = append(layoutSections, SectionLayoutInput{
+				Rows:      languageLegendRows(len(topLanguages)),
+				IsEmpty:   !hasLanguages,
+				Placement: StackVertical,
+			})
+		}
 	}
 
 	layoutInput := LayoutInput{
@@ -212,6 +293,14 @@ func buildViewModel(user domain.User, stats domain.StatsView, generatedAt time.T
 					link := fmt.Sprintf("https://github.com/%s/discussions?q=involves%%3A%s", r.Repo, user.Username)
 					badges = append(badges, BadgeVM{Count: fmt.Sprintf("%d", discCount), Icon: iconComment, Link: link})
 				}
+				if r.Releases > 0 {
+					link := fmt.Sprintf("https://github.com/%s/releases", r.Repo)
+					badges = append(badges, BadgeVM{Count: fmt.Sprintf("%d", r.Releases), Icon: iconTag, Link: link})
+				}
+				if r.SecurityAdvisories > 0 {
+					link := fmt.Sprintf("https://github.com/%s/security/advisories", r.Repo)
+					badges = append(badges, BadgeVM{Count: fmt.Sprintf("%d", r.SecurityAdvisories), Icon: iconShield, Link: link})
+				}
 
 				rows = append(rows, SectionRowVM{
 					Kind:      RowExternalContribution,
@@ -228,14 +317,37 @@ func buildViewModel(user domain.User, stats domain.StatsView, generatedAt time.T
 				Rows:         rows,
 			})
 		}
+
+		// Milestones Section
+		if opts.ShowMilestones && (!minimalSections || hasMilestones) {
+			var rows []SectionRowVM
+			for _, m := range topMilestones {
+				rows = append(rows, milestoneRow(m))
+			}
+			sections = append(sections, SectionVM{
+				Title:        "MILESTONES",
+				EmptyMessage: "No milestones yet",
+				Rows:         rows,
+			})
+		}
+
+		// Languages Section
+		if opts.ShowLanguages && (!minimalSections || hasLanguages) {
+			sections = append(sections, SectionVM{
+				Title:        "IMPACT BY LANGUAGE",
+				EmptyMessage: "No language data yet",
+				Languages:    topLanguages,
+			})
+		}
 	}
 
 	footer := FooterVM{
 		Y:           layout.Height - 25,
 		GeneratedAt: generatedAt.Format("02 Jan 2006"),
+		Range:       opts.Range,
 	}
 	if !layout.IsVertical {
-		footer.Attribution = `<a xlink:href="https://github.com/arayofcode/footprint" target="_blank"><text x="400" y="0" text-anchor="middle" font-family="system-ui, -apple-system, sans-serif" font-size="11" font-weight="600" fill="#22c55e" style="cursor: pointer;">Generated by Footprint</text></a>`
+		footer.Attribution = footerAttribution
 	}
 
 	return CardViewModel{
@@ -253,6 +365,50 @@ func buildViewModel(user domain.User, stats domain.StatsView, generatedAt time.T
 	}
 }
 
+// selectTopMilestones picks the most notable milestones for the card:
+// most popular repo first, earliest achievement as tiebreaker.
+func selectTopMilestones(milestones []domain.Milestone, limit int) []domain.Milestone {
+	ranked := make([]domain.Milestone, len(milestones))
+	copy(ranked, milestones)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Stars != ranked[j].Stars {
+			return ranked[i].Stars > ranked[j].Stars
+		}
+		return ranked[i].AchievedAt.Before(ranked[j].AchievedAt)
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+func milestoneRow(m domain.Milestone) SectionRowVM {
+	date := m.AchievedAt.Format("02 Jan 2006")
+	row := SectionRowVM{
+		Kind:     RowMilestone,
+		Subtitle: truncate(fmt.Sprintf("%s · %s", m.Repo, date), 60),
+		Link:     m.URL,
+	}
+	if m.Repo != "" {
+		row.AvatarKey = domain.RepoAvatarKey(m.Repo)
+	}
+
+	switch m.Kind {
+	case domain.MilestoneFirstMergedPR:
+		row.Title = "First merged PR"
+		if m.FirstTimer {
+			row.Title += " as a first-time contributor"
+		}
+	case domain.MilestoneFirstPopularRepo:
+		row.Title = fmt.Sprintf("First contribution to a %s★ repo", formatThreshold(m.Threshold))
+	case domain.MilestoneNewReposThisYear:
+		row.Title = fmt.Sprintf("%d new repos in %d", m.Count, m.Year)
+		row.Subtitle = "Latest " + date
+	}
+	row.Title = truncate(row.Title, 60)
+	return row
+}
+
 // renderSVG composes the final SVG string from ViewModel
 func renderSVG(vm CardViewModel, assetsMap map[domain.AssetKey]string) []byte {
 	resolveAsset := func(key domain.AssetKey) string {
@@ -271,6 +427,9 @@ func renderSVG(vm CardViewModel, assetsMap map[domain.AssetKey]string) []byte {
 	var statBoxes []string
 	for _, s := range vm.Stats {
 		statBoxes = append(statBoxes, renderStatBox(s.X, s.Y, s.Label, s.Value, s.Icon, s.Color))
+		if s.Delta != 0 {
+			statBoxes = append(statBoxes, renderStatTrend(s.X, s.Y, s.Delta))
+		}
 	}
 	if len(statBoxes) > 0 {
 		statsContent = fmt.Sprintf(`
@@ -283,7 +442,7 @@ func renderSVG(vm CardViewModel, assetsMap map[domain.AssetKey]string) []byte {
 	for i, sec := range vm.Sections {
 		// ZIP: Semantic Section with Layout Geometry
 		loc := vm.Layout.Sections[i]
-		body := renderSection(sec, vm.Layout, resolveAsset)
+		body := renderSection(sec, vm.Layout, loc.Width, resolveAsset)
 		sectionsContent += fmt.Sprintf(`
   <g transform="translate(%d, %d)">
     %s
@@ -325,17 +484,16 @@ func renderSVG(vm CardViewModel, assetsMap map[domain.AssetKey]string) []byte {
 	return []byte(svg)
 }
 
-func renderSection(sec SectionVM, layout LayoutVM, assetResolver func(domain.AssetKey) string) string {
+func renderSection(sec SectionVM, layout LayoutVM, cardWidth int, assetResolver func(domain.AssetKey) string) string {
+	if len(sec.Languages) > 0 {
+		return renderLanguageBar(sec.Languages, cardWidth)
+	}
 	if len(sec.Rows) == 0 {
 		return renderEmptyState(sec.EmptyMessage)
 	}
 
 	var sb strings.Builder
 	rowHeight := layout.RowHeight
-	cardWidth := SectionWidth
-	if layout.IsVertical {
-		cardWidth = VerticalSectionWidth
-	}
 
 	for i, row := range sec.Rows {
 		y := 35 + (i * rowHeight)
@@ -415,10 +573,10 @@ func renderHeader(user UserVM, avatar string) string {
 func renderFooter(footer FooterVM, width int) string {
 	return fmt.Sprintf(`
   <g transform="translate(40, %d)">
-    <text x="0" y="0" font-family="system-ui, -apple-system, sans-serif" font-size="11" fill="#6b7280">Range: All-time</text>
+    <text x="0" y="0" font-family="system-ui, -apple-system, sans-serif" font-size="11" fill="#6b7280">Range: %s</text>
     %s
     <text x="%d" y="0" text-anchor="end" font-family="system-ui, -apple-system, sans-serif" font-size="11" fill="#6b7280">Last Updated %s</text>
-  </g>`, footer.Y, footer.Attribution, width-80, footer.GeneratedAt)
+  </g>`, footer.Y, footer.Range, footer.Attribution, width-80, footer.GeneratedAt)
 }
 
 func formatCount(n int) string {
@@ -443,6 +601,13 @@ func renderSmallIconBox(icon string) string {
       </g>`, icon)
 }
 
+func formatThreshold(n int) string {
+	if n >= 1000 && n%1000 == 0 {
+		return fmt.Sprintf("%dk", n/1000)
+	}
+	return formatLargeNum(n)
+}
+
 func formatLargeNum(n int) string {
 	if n >= 1000 {
 		return fmt.Sprintf("%.1fk", float64(n)/1000.0)
@@ -463,16 +628,36 @@ func renderStatBox(x, y int, label, value, iconXML, color string) string {
     </g>`, x, y, color, color, color, iconXML, value, strings.ToUpper(label))
 }
 
+// renderStatTrend draws a stat's change, e.g. "▲ 3", above its value.
+func renderStatTrend(x, y, delta int) string {
+	arrow, color := "▲", "#22c55e"
+	if delta < 0 {
+		arrow, color, delta = "▼", "#ef4444", -delta
+	}
+	return fmt.Sprintf(`
+    <text x="%d" y="%d" font-family="system-ui, -apple-system, sans-serif" font-size="11" font-weight="600" fill="%s">%s %s</text>`, x+75, y+4, color, arrow, formatLargeNum(delta))
+}
+
 const (
-	iconPR      = `<path d="M16 19.25a3.25 3.25 0 1 1 6.5 0 3.25 3.25 0 0 1-6.5 0Zm-14.5 0a3.25 3.25 0 1 1 6.5 0 3.25 3.25 0 0 1-6.5 0Zm0-14.5a3.25 3.25 0 1 1 6.5 0 3.25 3.25 0 0 1-6.5 0ZM4.75 3a1.75 1.75 0 1 0 .001 3.501A1.75 1.75 0 0 0 4.75 3Zm0 14.5a1.75 1.75 0 1 0 .001 3.501A1.75 1.75 0 0 0 4.75 17.5Zm14.5 0a1.75 1.75 0 1 0 .001 3.501 1.75 1.75 0 0 0-.001-3.501Z"></path><path d="M13.405 1.72a.75.75 0 0 1 0 1.06L12.185 4h4.065A3.75 3.75 0 0 1 20 7.75v8.75a.75.75 0 0 1-1.5 0V7.75a2.25 2.25 0 0 0-2.25-2.25h-4.064l1.22 1.22a.75.75 0 0 1-1.061 1.06l-2.5-2.5a.75.75 0 0 1 0-1.06l2.5-2.5a.75.75 0 0 1 1.06 0ZM4.75 7.25A.75.75 0 0 1 5.5 8v8A.75.75 0 0 1 4 16V8a.75.75 0 0 1 .75-.75Z"></path>`
-	iconReview  = `<path d="M10.3 6.74a.75.75 0 0 1-.04 1.06l-2.908 2.7 2.908 2.7a.75.75 0 1 1-1.02 1.1l-3.5-3.25a.75.75 0 0 1 0-1.1l3.5-3.25a.75.75 0 0 1 1.06.04Zm3.44 1.06a.75.75 0 1 1 1.02-1.1l3.5 3.25a.75.75 0 0 1 0 1.1l-3.5 3.25a.75.75 0 1 1-1.02-1.1l2.908-2.7-2.908-2.7Z"></path><path d="M1.5 4.25c0-.966.784-1.75 1.75-1.75h17.5c.966 0 1.75.784 1.75 1.75v12.5a1.75 1.75 0 0 1-1.75 1.75h-9.69l-3.573 3.573A1.458 1.458 0 0 1 5 21.043V18.5H3.25a1.75 1.75 0 0 1-1.75-1.75ZM3.25 4a.25.25 0 0 0-.25.25v12.5c0 .138.112.25.25.25h2.5a.75.75 0 0 1 .75.75v3.19l3.72-3.72a.749.749 0 0 1 .53-.22h10a.25.25 0 0 0 .25-.25V4.25a.25.25 0 0 0-.25-.25Z"></path>`
-	iconIssue   = `<path d="M12 1c6.075 0 11 4.925 11 11s-4.925 11-11 11S1 18.075 1 12 5.925 1 12 1ZM2.5 12a9.5 9.5 0 0 0 9.5 9.5 9.5 9.5 0 0 0 9.5-9.5A9.5 9.5 0 0 0 12 2.5 9.5 9.5 0 0 0 2.5 12Zm9.5 2a2 2 0 1 1-.001-3.999A2 2 0 0 1 12 14Z"></path>`
-	iconComment = `<path d="M1.5 4.25c0-.966.784-1.75 1.75-1.75h17.5c.966 0 1.75.784 1.75 1.75v12.5a1.75 1.75 0 0 1-1.75 1.75h-9.69l-3.573 3.573A1.458 1.458 0 0 1 5 21.043V18.5H3.25a1.75 1.75 0 0 1-1.75-1.75ZM3.25 4a.25.25 0 0 0-.25.25v12.5c0 .138.112.25.25.25h2.5a.75.75 0 0 1 .75.75v3.19l3.72-3.72a.749.749 0 0 1 .53-.22h10a.25.25 0 0 0 .25-.25V4.25a.25.25 0 0 0-.25-.25Z"></path>`
-	iconProject = `<path d="M3 2.75A2.75 2.75 0 0 1 5.75 0h14.5a.75.75 0 0 1 .75.75v20.5a.75.75 0 0 1-.75.75h-6a.75.75 0 0 1 0-1.5h5.25v-4H6A1.5 1.5 0 0 0 4.5 18v.75c0 .716.43 1.334 1.05 1.605a.75.75 0 0 1-.6 1.374A3.251 3.251 0 0 1 3 18.75ZM19.5 1.5H5.75c-.69 0-1.25.56-1.25 1.25v12.651A2.989 2.989 0 0 1 6 15h13.5Z"></path><path d="M7 18.25a.25.25 0 0 1 .25-.25h5a.25.25 0 0 1 .25.25v5.01a.25.25 0 0 1-.397.201l-2.206-1.604a.25.25 0 0 0-.294 0L7.397 23.46a.25.25 0 0 1-.397-.2v-5.01Z"></path>`
-	iconStar    = `<path d="M12 .25a.75.75 0 0 1 .673.418l3.058 6.197 6.839.994a.75.75 0 0 1 .415 1.279l-4.948 4.823 1.168 6.811a.751.751 0 0 1-1.088.791L12 18.347l-6.117 3.216a.75.75 0 0 1-1.088-.79l1.168-6.812-4.948-4.823a.75.75 0 0 1 .416-1.28l6.838-.993L11.328.668A.75.75 0 0 1 12 .25Zm0 2.445L9.44 7.882a.75.75 0 0 1-.565.41l-5.725.832 4.143 4.038a.748.748 0 0 1 .215.664l-.978 5.702 5.121-2.692a.75.75 0 0 1 .698 0l5.12 2.692-.977-5.702a.748.748 0 0 1 .215-.664l4.143-4.038-5.725-.831a.75.75 0 0 1-.565-.41L12 2.694Z"></path>`
+	iconPR       = `<path d="M16 19.25a3.25 3.25 0 1 1 6.5 0 3.25 3.25 0 0 1-6.5 0Zm-14.5 0a3.25 3.25 0 1 1 6.5 0 3.25 3.25 0 0 1-6.5 0Zm0-14.5a3.25 3.25 0 1 1 6.5 0 3.25 3.25 0 0 1-6.5 0ZM4.75 3a1.75 1.75 0 1 0 .001 3.501A1.75 1.75 0 0 0 4.75 3Zm0 14.5a1.75 1.75 0 1 0 .001 3.501A1.75 1.75 0 0 0 4.75 17.5Zm14.5 0a1.75 1.75 0 1 0 .001 3.501 1.75 1.75 0 0 0-.001-3.501Z"></path><path d="M13.405 1.72a.75.75 0 0 1 0 1.06L12.185 4h4.065A3.75 3.75 0 0 1 20 7.75v8.75a.75.75 0 0 1-1.5 0V7.75a2.25 2.25 0 0 0-2.25-2.25h-4.064l1.22 1.22a.75.75 0 0 1-1.061 1.06l-2.5-2.5a.75.75 0 0 1 0-1.06l2.5-2.5a.75.75 0 0 1 1.06 0ZM4.75 7.25A.75.75 0 0 1 5.5 8v8A.75.75 0 0 1 4 16V8a.75.75 0 0 1 .75-.75Z"></path>`
+	iconReview   = `<path d="M10.3 6.74a.75.75 0 0 1-.04 1.06l-2.908 2.7 2.908 2.7a.75.75 0 1 1-1.02 1.1l-3.5-3.25a.75.75 0 0 1 0-1.1l3.5-3.25a.75.75 0 0 1 1.06.04Zm3.44 1.06a.75.75 0 1 1 1.02-1.1l3.5 3.25a.75.75 0 0 1 0 1.1l-3.5 3.25a.75.75 0 1 1-1.02-1.1l2.908-2.7-2.908-2.7Z"></path><path d="M1.5 4.25c0-.966.784-1.75 1.75-1.75h17.5c.966 0 1.75.784 1.75 1.75v12.5a1.75 1.75 0 0 1-1.75 1.75h-9.69l-3.573 3.573A1.458 1.458 0 0 1 5 21.043V18.5H3.25a1.75 1.75 0 0 1-1.75-1.75ZM3.25 4a.25.25 0 0 0-.25.25v12.5c0 .138.112.25.25.25h2.5a.75.75 0 0 1 .75.75v3.19l3.72-3.72a.749.749 0 0 1 .53-.22h10a.25.25 0 0 0 .25-.25V4.25a.25.25 0 0 0-.25-.25Z"></path>`
+	iconIssue    = `<path d="M12 1c6.075 0 11 4.925 11 11s-4.925 11-11 11S1 18.075 1 12 5.925 1 12 1ZM2.5 12a9.5 9.5 0 0 0 9.5 9.5 9.5 9.5 0 0 0 9.5-9.5A9.5 9.5 0 0 0 12 2.5 9.5 9.5 0 0 0 2.5 12Zm9.5 2a2 2 0 1 1-.001-3.999A2 2 0 0 1 12 14Z"></path>`
+	iconTriage   = `<path d="M3.5 3.75a.25.25 0 0 1 .25-.25h13.5a.25.25 0 0 1 .25.25v10a.75.75 0 0 0 1.5 0v-10A1.75 1.75 0 0 0 17.25 2H3.75A1.75 1.75 0 0 0 2 3.75v16.5c0 .966.784 1.75 1.75 1.75h7a.75.75 0 0 0 0-1.5h-7a.25.25 0 0 1-.25-.25Z"></path><path d="M6.25 7a.75.75 0 0 0 0 1.5h8.5a.75.75 0 0 0 0-1.5Zm-.75 4.75a.75.75 0 0 1 .75-.75h4.5a.75.75 0 0 1 0 1.5h-4.5a.75.75 0 0 1-.75-.75Zm16.28 4.53a.75.75 0 1 0-1.06-1.06l-4.97 4.97-1.97-1.97a.75.75 0 1 0-1.06 1.06l2.5 2.5a.75.75 0 0 0 1.06 0Z"></path>`
+	iconComment  = `<path d="M1.5 4.25c0-.966.784-1.75 1.75-1.75h17.5c.966 0 1.75.784 1.75 1.75v12.5a1.75 1.75 0 0 1-1.75 1.75h-9.69l-3.573 3.573A1.458 1.458 0 0 1 5 21.043V18.5H3.25a1.75 1.75 0 0 1-1.75-1.75ZM3.25 4a.25.25 0 0 0-.25.25v12.5c0 .138.112.25.25.25h2.5a.75.75 0 0 1 .75.75v3.19l3.72-3.72a.749.749 0 0 1 .53-.22h10a.25.25 0 0 0 .25-.25V4.25a.25.25 0 0 0-.25-.25Z"></path>`
+	iconPeople   = `<path d="M3.5 8a5.5 5.5 0 1 1 8.596 4.547 9.005 9.005 0 0 1 5.9 8.18.751.751 0 0 1-1.5.045 7.5 7.5 0 0 0-14.993 0 .75.75 0 0 1-1.499-.044 9.005 9.005 0 0 1 5.9-8.181A5.496 5.496 0 0 1 3.5 8ZM9 4a4 4 0 1 0 0 8 4 4 0 0 0 0-8Zm8.29 4c-.148 0-.292.01-.434.03a.75.75 0 1 1-.212-1.484 4.53 4.53 0 0 1 3.38 8.097 6.69 6.69 0 0 1 2.975 5.575.75.75 0 0 1-1.5 0 5.193 5.193 0 0 0-3.15-4.776.75.75 0 0 1-.044-1.37A3.03 3.03 0 0 0 17.29 8Z"></path>`
+	iconCalendar = `<path d="M3.25 4h17.5c.966 0 1.75.784 1.75 1.75v15.5A1.75 1.75 0 0 1 20.75 23H3.25a1.75 1.75 0 0 1-1.75-1.75V5.75C1.5 4.784 2.284 4 3.25 4ZM3 10v11.25c0 .138.112.25.25.25h17.5a.25.25 0 0 0 .25-.25V10ZM7 .5a.75.75 0 0 1 .75.75V4h-1.5V1.25A.75.75 0 0 1 7 .5Zm10 0a.75.75 0 0 1 .75.75V4h-1.5V1.25A.75.75 0 0 1 17 .5Z"></path>`
+	iconProject  = `<path d="M3 2.75A2.75 2.75 0 0 1 5.75 0h14.5a.75.75 0 0 1 .75.75v20.5a.75.75 0 0 1-.75.75h-6a.75.75 0 0 1 0-1.5h5.25v-4H6A1.5 1.5 0 0 0 4.5 18v.75c0 .716.43 1.334 1.05 1.605a.75.75 0 0 1-.6 1.374A3.251 3.251 0 0 1 3 18.75ZM19.5 1.5H5.75c-.69 0-1.25.56-1.25 1.25v12.651A2.989 2.989 0 0 1 6 15h13.5Z"></path><path d="M7 18.25a.25.25 0 0 1 .25-.25h5a.25.25 0 0 1 .25.25v5.01a.25.25 0 0 1-.397.201l-2.206-1.604a.25.25 0 0 0-.294 0L7.397 23.46a.25.25 0 0 1-.397-.2v-5.01Z"></path>`
+	iconLock     = `<path d="M6 9V7.25C6 3.845 8.503 1 12 1s6 2.845 6 6.25V9h.5a2.5 2.5 0 0 1 2.5 2.5v8a2.5 2.5 0 0 1-2.5 2.5h-13A2.5 2.5 0 0 1 3 19.5v-8A2.5 2.5 0 0 1 5.5 9Zm-1.5 2.5v8a1 1 0 0 0 1 1h13a1 1 0 0 0 1-1v-8a1 1 0 0 0-1-1h-13a1 1 0 0 0-1 1Zm3-4.25V9h9V7.25c0-2.67-1.922-4.75-4.5-4.75-2.578 0-4.5 2.08-4.5 4.75Z"></path>`
+	iconShield   = `<path d="M11.46.637a1.748 1.748 0 0 1 1.08 0l8.25 2.675A1.75 1.75 0 0 1 22 4.976V10c0 6.19-3.77 10.705-9.401 12.83a1.704 1.704 0 0 1-1.198 0C5.771 20.704 2 16.19 2 10V4.976c0-.76.49-1.43 1.21-1.664Zm.617 1.426a.253.253 0 0 0-.154 0L3.673 4.74a.25.25 0 0 0-.173.237V10c0 5.461 3.28 9.483 8.43 11.426a.199.199 0 0 0 .14 0C17.22 19.483 20.5 15.46 20.5 10V4.976a.25.25 0 0 0-.173-.237Z"></path>`
+	iconTag      = `<path d="M7.75 6.5a1.25 1.25 0 1 1 0 2.5 1.25 1.25 0 0 1 0-2.5Z"></path><path d="M2.5 1h8.44a1.5 1.5 0 0 1 1.06.44l10.25 10.25a1.5 1.5 0 0 1 0 2.12l-8.44 8.44a1.5 1.5 0 0 1-2.12 0L1.44 12A1.497 1.497 0 0 1 1 10.94V2.5A1.5 1.5 0 0 1 2.5 1Zm0 1.5v8.44l10.25 10.25 8.44-8.44L10.94 2.5Z"></path>`
+	iconStar     = `<path d="M12 .25a.75.75 0 0 1 .673.418l3.058 6.197 6.839.994a.75.75 0 0 1 .415 1.279l-4.948 4.823 1.168 6.811a.751.751 0 0 1-1.088.791L12 18.347l-6.117 3.216a.75.75 0 0 1-1.088-.79l1.168-6.812-4.948-4.823a.75.75 0 0 1 .416-1.28l6.838-.993L11.328.668A.75.75 0 0 1 12 .25Zm0 2.445L9.44 7.882a.75.75 0 0 1-.565.41l-5.725.832 4.143 4.038a.748.748 0 0 1 .215.664l-.978 5.702 5.121-2.692a.75.75 0 0 1 .698 0l5.12 2.692-.977-5.702a.748.748 0 0 1 .215-.664l4.143-4.038-5.725-.831a.75.75 0 0 1-.565-.41L12 2.694Z"></path>`
 )
 
 // ---- Visual primitives ----
+
+// footerAttribution links back to the project from a landscape card's footer.
+const footerAttribution = `<a xlink:href="https://github.com/arayofcode/footprint" target="_blank"><text x="400" y="0" text-anchor="middle" font-family="system-ui, -apple-system, sans-serif" font-size="11" font-weight="600" fill="#22c55e" style="cursor: pointer;">Generated by Footprint</text></a>`
+
 func renderSectionHeader(title string) string {
 	return fmt.Sprintf(
 		`<text x="0" y="20" font-family="system-ui, -apple-system, sans-serif" font-size="14" font-weight="700" fill="#9ca3af" letter-spacing="1">%s</text>`,
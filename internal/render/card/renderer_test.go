@@ -26,7 +26,7 @@ func TestRenderCard_GeneratesSVGWithStats(t *testing.T) {
 		IssueComments: 10,
 	}
 
-	out, err := renderer.RenderCard(context.Background(), user, stats, time.Now(), events, projects, nil)
+	out, err := renderer.RenderCard(context.Background(), user, stats, time.Now(), events, projects, domain.Insights{}, nil)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -85,7 +85,7 @@ func TestRenderMinimalCard_HidesZeroStats(t *testing.T) {
 		IssueComments: 0, // Zero - should be hidden
 	}
 
-	out, err := renderer.RenderMinimalCard(context.Background(), user, stats, time.Now(), nil, nil, nil)
+	out, err := renderer.RenderMinimalCard(context.Background(), user, stats, time.Now(), nil, nil, domain.Insights{}, nil)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -120,7 +120,7 @@ func TestRenderExtendedCard_IncludesSections(t *testing.T) {
 	user := domain.User{Username: "ray"}
 	stats := domain.StatsView{PRsOpened: 5}
 
-	out, err := renderer.RenderExtendedCard(context.Background(), user, stats, time.Now(), events, projects, nil)
+	out, err := renderer.RenderExtendedCard(context.Background(), user, stats, time.Now(), events, projects, domain.Insights{}, nil)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -142,7 +142,7 @@ func TestRenderExtendedMinimalCard_HidesEmptySections(t *testing.T) {
 	stats := domain.StatsView{PRsOpened: 5}
 
 	// No events and no projects - sections should be hidden
-	out, err := renderer.RenderExtendedMinimalCard(context.Background(), user, stats, time.Now(), nil, nil, nil)
+	out, err := renderer.RenderExtendedMinimalCard(context.Background(), user, stats, time.Now(), nil, nil, domain.Insights{}, nil)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -167,7 +167,7 @@ func TestRenderExtendedMinimalCard_ShiftsExternalToLeft(t *testing.T) {
 	}
 
 	// No projects, but has events. Key Contributions should move to x=40.
-	out, err := renderer.RenderExtendedMinimalCard(context.Background(), user, stats, time.Now(), events, nil, nil)
+	out, err := renderer.RenderExtendedMinimalCard(context.Background(), user, stats, time.Now(), events, nil, domain.Insights{}, nil)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -188,7 +188,7 @@ func TestRenderCard_SVGContainsCoreSections(t *testing.T) {
 	user := domain.User{Username: "ray"}
 	stats := domain.StatsView{PRsOpened: 5}
 
-	out, err := renderer.RenderCard(context.Background(), user, stats, time.Now(), nil, nil, nil)
+	out, err := renderer.RenderCard(context.Background(), user, stats, time.Now(), nil, nil, domain.Insights{}, nil)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -203,6 +203,30 @@ func TestRenderCard_SVGContainsCoreSections(t *testing.T) {
 	}
 }
 
+func TestRenderCard_FooterShowsRange(t *testing.T) {
+	user := domain.User{Username: "ray"}
+
+	out, err := Renderer{}.RenderCard(context.Background(), user, domain.StatsView{}, time.Now(), nil, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(string(out), "Range: All-time") {
+		t.Error("expected all-time range without a window")
+	}
+
+	renderer := Renderer{Window: domain.TimeWindow{
+		Since: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}}
+	out, err = renderer.RenderCard(context.Background(), user, domain.StatsView{}, time.Now(), nil, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(string(out), "Range: 2024") {
+		t.Error("expected the window in the footer")
+	}
+}
+
 func TestRenderCard_IsDeterministic(t *testing.T) {
 	renderer := Renderer{}
 	user := domain.User{Username: "ray", AvatarURL: "https://example.com/avatar.png"}
@@ -217,12 +241,12 @@ func TestRenderCard_IsDeterministic(t *testing.T) {
 	}
 	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	out1, err := renderer.RenderCard(context.Background(), user, stats, now, events, nil, assets)
+	out1, err := renderer.RenderCard(context.Background(), user, stats, now, events, nil, domain.Insights{}, assets)
 	if err != nil {
 		t.Fatalf("first render failed: %v", err)
 	}
 
-	out2, err := renderer.RenderCard(context.Background(), user, stats, now, events, nil, assets)
+	out2, err := renderer.RenderCard(context.Background(), user, stats, now, events, nil, domain.Insights{}, assets)
 	if err != nil {
 		t.Fatalf("second render failed: %v", err)
 	}
@@ -247,7 +271,7 @@ func TestRegression_Labels(t *testing.T) {
 	}
 
 	for _, c := range testcases {
-		out, err := renderer.RenderMinimalCard(context.Background(), user, c.stats, time.Now(), nil, nil, nil)
+		out, err := renderer.RenderMinimalCard(context.Background(), user, c.stats, time.Now(), nil, nil, domain.Insights{}, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -280,7 +304,7 @@ func TestRenderSection_DoesNotInferFromBadges(t *testing.T) {
 		},
 	}
 
-	svg := renderSection(sec, layout, resolve)
+	svg := renderSection(sec, layout, SectionWidth, resolve)
 
 	// External layout uses currentX := -50 and translates by cardWidth-5 (340-5=335 or 420-5=415)
 	// Owned layout uses translate cardWidth-80 (340-80=260 or 420-80=340)
@@ -305,7 +329,7 @@ func TestRenderSection_ShowsEmptyMessage(t *testing.T) {
 		Rows:         nil,
 	}
 
-	svg := renderSection(sec, layout, nil)
+	svg := renderSection(sec, layout, SectionWidth, nil)
 	if !strings.Contains(svg, "Nothing here") {
 		t.Error("expected empty message to be rendered")
 	}
@@ -371,6 +395,7 @@ func TestRenderCard_IsDeterministic_WithSections(t *testing.T) {
 		now,
 		repos,
 		projects,
+		domain.Insights{},
 		assets,
 	)
 	if err != nil {
@@ -384,6 +409,7 @@ func TestRenderCard_IsDeterministic_WithSections(t *testing.T) {
 		now,
 		repos,
 		projects,
+		domain.Insights{},
 		assets,
 	)
 	if err != nil {
@@ -413,7 +439,7 @@ func TestRenderExtendedCard_BadgeLogicAndURLs(t *testing.T) {
 		},
 	}
 
-	out, err := renderer.RenderExtendedCard(context.Background(), user, stats, time.Now(), repos, nil, nil)
+	out, err := renderer.RenderExtendedCard(context.Background(), user, stats, time.Now(), repos, nil, domain.Insights{}, nil)
 	if err != nil {
 		t.Fatalf("render failed: %v", err)
 	}
@@ -446,3 +472,165 @@ func TestRenderExtendedCard_BadgeLogicAndURLs(t *testing.T) {
 		t.Errorf("expected Issue URL %q", expectedIssueURL)
 	}
 }
+
+func TestRenderExtendedCard_SecurityAdvisoryBadgeAndStat(t *testing.T) {
+	renderer := Renderer{}
+	user := domain.User{Username: "ray"}
+	stats := domain.StatsView{SecurityAdvisories: 2}
+	repos := []domain.RepoContribution{
+		{Repo: "org/repo", SecurityAdvisories: 2},
+	}
+
+	out, err := renderer.RenderExtendedCard(context.Background(), user, stats, time.Now(), repos, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	svg := string(out)
+	if !strings.Contains(svg, "SECURITY ADVISORIES") {
+		t.Error("expected security advisories stat")
+	}
+	if strings.Contains(svg, iconTag) {
+		t.Error("expected no release badge without releases")
+	}
+	if !strings.Contains(svg, iconShield) || !strings.Contains(svg, "https://github.com/org/repo/security/advisories") {
+		t.Error("expected security advisory badge linking to the repo's advisories")
+	}
+}
+
+func TestRenderExtendedCard_MilestonesSection(t *testing.T) {
+	user := domain.User{Username: "ray"}
+	stats := domain.StatsView{PRsOpened: 1}
+	insights := domain.Insights{
+		Milestones: []domain.Milestone{
+			{Kind: domain.MilestoneFirstMergedPR, Repo: "small/repo", URL: "https://github.com/small/repo/pull/1", Stars: 10, AchievedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Kind: domain.MilestoneFirstPopularRepo, Repo: "big/repo", URL: "https://github.com/big/repo/pull/2", Stars: 20000, Threshold: 10000, AchievedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	out, err := Renderer{}.RenderExtendedCard(context.Background(), user, stats, time.Now(), nil, nil, insights, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if strings.Contains(string(out), "MILESTONES") {
+		t.Error("expected milestones section to be opt-in")
+	}
+
+	out, err = Renderer{ShowMilestones: true}.RenderExtendedCard(context.Background(), user, stats, time.Now(), nil, nil, insights, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	svg := string(out)
+	if !strings.Contains(svg, "MILESTONES") {
+		t.Error("expected SVG to contain 'MILESTONES' section")
+	}
+	if !strings.Contains(svg, "First contribution to a 10k★ repo") {
+		t.Error("expected popular repo milestone row")
+	}
+	if strings.Index(svg, "big/repo") > strings.Index(svg, "small/repo") {
+		t.Error("expected milestones ranked by repo popularity")
+	}
+}
+
+func TestRenderExtendedMinimalCard_HidesEmptyMilestones(t *testing.T) {
+	user := domain.User{Username: "ray"}
+	out, err := Renderer{ShowMilestones: true}.RenderExtendedMinimalCard(context.Background(), user, domain.StatsView{PRsOpened: 1}, time.Now(), nil, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if strings.Contains(string(out), "MILESTONES") {
+		t.Error("expected empty milestones section to be hidden on the minimal variant")
+	}
+}
+
+func TestRenderCard_PrivateContributionsStatIsOptIn(t *testing.T) {
+	renderer := Renderer{}
+	user := domain.User{Username: "ray"}
+
+	out, err := renderer.RenderCard(context.Background(), user, domain.StatsView{}, time.Now(), nil, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if strings.Contains(string(out), "PRIVATE CONTRIBUTIONS") {
+		t.Error("expected private contributions stat to be hidden by default")
+	}
+
+	stats := domain.StatsView{Private: &domain.PrivateContributions{Restricted: 40, Commits: 2}}
+	out, err = renderer.RenderCard(context.Background(), user, stats, time.Now(), nil, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	svg := string(out)
+	if !strings.Contains(svg, "PRIVATE CONTRIBUTIONS") || !strings.Contains(svg, ">42<") {
+		t.Error("expected private contributions stat with total 42")
+	}
+}
+
+func TestRenderExtendedCard_ReleaseBadge(t *testing.T) {
+	renderer := Renderer{}
+	user := domain.User{Username: "ray"}
+	repos := []domain.RepoContribution{
+		{Repo: "org/repo", Releases: 4},
+	}
+
+	out, err := renderer.RenderExtendedCard(context.Background(), user, domain.StatsView{ReleasesPublished: 4}, time.Now(), repos, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	svg := string(out)
+	if !strings.Contains(svg, iconTag) || !strings.Contains(svg, "https://github.com/org/repo/releases") || !strings.Contains(svg, ">4<") {
+		t.Error("expected release badge with count 4 linking to the repo's releases")
+	}
+}
+
+func TestRenderCard_TriageActionsStat(t *testing.T) {
+	renderer := Renderer{}
+	user := domain.User{Username: "ray"}
+
+	out, err := renderer.RenderMinimalCard(context.Background(), user, domain.StatsView{PRsOpened: 1}, time.Now(), nil, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if strings.Contains(string(out), "TRIAGE ACTIONS") {
+		t.Error("expected triage stat to be hidden without triage actions")
+	}
+
+	out, err = renderer.RenderMinimalCard(context.Background(), user, domain.StatsView{PRsOpened: 1, TriageActions: 37}, time.Now(), nil, nil, domain.Insights{}, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	svg := string(out)
+	if !strings.Contains(svg, "TRIAGE ACTIONS") || !strings.Contains(svg, iconTriage) || !strings.Contains(svg, ">37<") {
+		t.Error("expected triage actions stat with count 37")
+	}
+}
+
+func TestRenderCard_TrendArrowsAreOptIn(t *testing.T) {
+	user := domain.User{Username: "ray"}
+	stats := domain.StatsView{PRsOpened: 5, StarsEarned: 1200}
+	insights := domain.Insights{Trend: &domain.Trend{Stats: domain.StatsView{PRsOpened: 2, StarsEarned: -1500}}}
+
+	out, err := Renderer{}.RenderCard(context.Background(), user, stats, time.Now(), nil, nil, insights, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if strings.Contains(string(out), "▲") || strings.Contains(string(out), "▼") {
+		t.Error("expected trend arrows to be opt-in")
+	}
+
+	out, err = Renderer{ShowTrend: true}.RenderCard(context.Background(), user, stats, time.Now(), nil, nil, insights, nil)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	svg := string(out)
+	if !strings.Contains(svg, "▲ 2<") {
+		t.Error("expected an up arrow for two more PRs")
+	}
+	if !strings.Contains(svg, "▼ 1.5k<") {
+		t.Error("expected a down arrow for 1.5k fewer stars")
+	}
+	if strings.Count(svg, "▲")+strings.Count(svg, "▼") != 2 {
+		t.Error("expected no arrows on unchanged stats")
+	}
+}
@@ -9,6 +9,7 @@ type RowKind int
 const (
 	RowOwnedProject RowKind = iota
 	RowExternalContribution
+	RowMilestone
 )
 
 type CardViewModel struct {
@@ -32,6 +33,7 @@ type StatVM struct {
 	Value string
 	Icon  string
 	Raw   int
+	Delta int // Change since the history baseline, zero when trends are off
 	X     int
 	Y     int
 	Color string
@@ -41,6 +43,7 @@ type SectionVM struct {
 	Title        string
 	EmptyMessage string // Message to show if Rows is empty.
 	Rows         []SectionRowVM
+	Languages    []LanguageVM // Drawn as a bar and legend instead of Rows
 }
 
 type SectionRowVM struct {
@@ -61,6 +64,7 @@ type BadgeVM struct {
 type FooterVM struct {
 	Y           int
 	GeneratedAt string
+	Range       string // e.g. "All-time" or "2025"
 	Attribution string
 }
 
@@ -77,6 +81,66 @@ type LayoutVM struct {
 }
 
 type SectionLayoutVM struct {
-	X int
-	Y int
+	X     int
+	Y     int
+	Width int
+}
+
+type LeaderboardViewModel struct {
+	Width  int
+	Height int
+	User   UserVM
+	Stats  []StatVM
+	Rows   []LeaderboardRowVM
+	Footer FooterVM
+}
+
+type LeaderboardRowVM struct {
+	Rank      int
+	Username  string
+	Link      string
+	AvatarKey domain.AssetKey
+	Upstreams string // Main upstream repos, shown under the username
+	RepoCount int    // Upstream repos contributed to, shown as a badge
+	Score     string
+	BarWidth  int // Score bar length, relative to the top member
+}
+
+type HeatmapViewModel struct {
+	Width  int
+	Height int
+	User   UserVM
+	Stats  []StatVM
+	Cells  []HeatmapCellVM
+	Months []HeatmapMonthVM
+	Footer FooterVM
+}
+
+type HeatmapCellVM struct {
+	Column  int
+	Row     int // Weekday, 0 is Monday
+	Level   int // Intensity from 0 (no score) to heatmapLevels-1
+	Tooltip string
+}
+
+type HeatmapMonthVM struct {
+	Column int // Week the month starts in
+	Label  string
+}
+
+type LanguageVM struct {
+	Name    string
+	Color   string
+	Share   float64 // Fraction of the score, 0 to 1
+	Percent string  // e.g. "42.3%"
+	Score   string
+	Repos   int
+}
+
+type LanguageCardViewModel struct {
+	Width     int
+	Height    int
+	User      UserVM
+	Languages []LanguageVM
+	Footer    FooterVM
 }
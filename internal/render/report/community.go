@@ -0,0 +1,138 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+type CommunityReport struct {
+	SchemaVersion         string                 `json:"schemaVersion"`
+	GeneratedAt           time.Time              `json:"generatedAt"`
+	Range                 RangeInfo              `json:"range"`
+	Owner                 string                 `json:"owner"`
+	Repos                 []string               `json:"repos"`
+	Stats                 domain.StatsView       `json:"stats"`
+	TotalScore            float64                `json:"totalScore"`
+	LifetimeScore         float64                `json:"lifetimeScore"`
+	TotalEvents           int                    `json:"totalEvents"`
+	Contributors          []CommunityContributor `json:"contributors"`
+	FirstTimeContributors []PeriodCount          `json:"firstTimeContributors"`
+	ReviewLoad            ReviewLoad             `json:"reviewLoad"`
+	BusFactor             BusFactor              `json:"busFactor"`
+	RepoBreakdown         []RepoImpact           `json:"repoBreakdown"`
+	Scoring               *ScoringInfo           `json:"scoring,omitempty"`
+}
+
+type CommunityContributor struct {
+	Rank              int              `json:"rank"`
+	Username          string           `json:"username"`
+	ImpactScore       float64          `json:"impactScore"`
+	FirstContribution time.Time        `json:"firstContribution"`
+	Stats             domain.StatsView `json:"stats"`
+}
+
+type PeriodCount struct {
+	Period string `json:"period"`
+	Count  int    `json:"count"`
+}
+
+type ReviewLoad struct {
+	PullRequests           int            `json:"pullRequests"`
+	Reviewed               int            `json:"reviewed"`
+	Reviews                int            `json:"reviews"`
+	MedianFirstReviewHours float64        `json:"medianFirstReviewHours"`
+	MaintainerReviews      int            `json:"maintainerReviews"`
+	CommunityReviewers     int            `json:"communityReviewers"`
+	Reviewers              []ReviewerLoad `json:"reviewers"`
+}
+
+type ReviewerLoad struct {
+	Username string  `json:"username"`
+	Reviews  int     `json:"reviews"`
+	Share    float64 `json:"share"`
+}
+
+type BusFactor struct {
+	Authors          int     `json:"authors"`
+	Reviewers        int     `json:"reviewers"`
+	TopAuthorShare   float64 `json:"topAuthorShare"`
+	TopReviewerShare float64 `json:"topReviewerShare"`
+}
+
+func (r Renderer) RenderCommunityReport(ctx context.Context, community domain.CommunityFootprint, generatedAt time.Time) ([]byte, error) {
+	_ = ctx
+
+	contributors := make([]CommunityContributor, 0, len(community.Contributors))
+	for i, c := range community.Contributors {
+		contributors = append(contributors, CommunityContributor{
+			Rank:              i + 1,
+			Username:          c.User.Username,
+			ImpactScore:       c.Score,
+			FirstContribution: c.FirstContribution,
+			Stats:             c.Stats,
+		})
+	}
+
+	firstTimers := make([]PeriodCount, 0, len(community.FirstTimers))
+	for _, p := range community.FirstTimers {
+		firstTimers = append(firstTimers, PeriodCount{Period: p.Period, Count: p.Count})
+	}
+
+	load := community.Reviews
+	reviewers := make([]ReviewerLoad, 0, len(load.Reviewers))
+	for _, reviewer := range load.Reviewers {
+		reviewers = append(reviewers, ReviewerLoad{Username: reviewer.User.Username, Reviews: reviewer.Reviews, Share: reviewer.Share})
+	}
+
+	repos := make([]RepoImpact, 0, len(community.Repos))
+	for _, repo := range community.Repos {
+		repos = append(repos, RepoImpact{
+			Repo:        repo.Repo,
+			RepoURL:     repo.RepoURL,
+			ImpactScore: repo.Score,
+			PRCount:     repo.PRsOpened,
+		})
+	}
+
+	report := CommunityReport{
+		SchemaVersion:         "1",
+		GeneratedAt:           generatedAt,
+		Range:                 r.rangeInfo(),
+		Owner:                 community.Scope.Owner,
+		Repos:                 community.Scope.Repos,
+		Stats:                 community.Stats,
+		TotalScore:            community.TotalScore,
+		LifetimeScore:         community.LifetimeScore,
+		TotalEvents:           community.Events,
+		Contributors:          contributors,
+		FirstTimeContributors: firstTimers,
+		ReviewLoad: ReviewLoad{
+			PullRequests:           load.PullRequests,
+			Reviewed:               load.Reviewed,
+			Reviews:                load.Reviews,
+			MedianFirstReviewHours: load.MedianFirstReview.Hours(),
+			MaintainerReviews:      load.MaintainerReviews,
+			CommunityReviewers:     load.CommunityReviewers,
+			Reviewers:              reviewers,
+		},
+		BusFactor: BusFactor{
+			Authors:          community.BusFactor.Authors,
+			Reviewers:        community.BusFactor.Reviewers,
+			TopAuthorShare:   community.BusFactor.TopAuthorShare,
+			TopReviewerShare: community.BusFactor.TopReviewerShare,
+		},
+		RepoBreakdown: repos,
+		Scoring:       r.scoringInfo(),
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling community report: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,53 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestRenderCommunityReport(t *testing.T) {
+	community := domain.CommunityFootprint{
+		Scope: domain.CommunityScope{Owner: "ray", Repos: []string{"ray/tool"}},
+		Contributors: []domain.CommunityContributor{
+			{MemberFootprint: domain.MemberFootprint{User: domain.User{Username: "ana"}, Score: 20}},
+			{MemberFootprint: domain.MemberFootprint{User: domain.User{Username: "bo"}, Score: 5}},
+		},
+		FirstTimers: []domain.PeriodCount{{Period: "2025-01", Count: 2}},
+		Reviews: domain.ReviewLoad{
+			Reviews:           4,
+			MedianFirstReview: 90 * time.Minute,
+			Reviewers:         []domain.ReviewerLoad{{User: domain.User{Username: "ray"}, Reviews: 4, Share: 1}},
+		},
+		BusFactor: domain.BusFactor{Authors: 1, TopAuthorShare: 0.8},
+	}
+
+	out, err := Renderer{}.RenderCommunityReport(context.Background(), community, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var report CommunityReport
+	if err := json.Unmarshal(out, &report); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+
+	if report.Owner != "ray" || len(report.Repos) != 1 {
+		t.Errorf("unexpected scope %s %v", report.Owner, report.Repos)
+	}
+	if report.Contributors[1].Rank != 2 || report.Contributors[1].Username != "bo" {
+		t.Errorf("expected contributors ranked by score, got %+v", report.Contributors)
+	}
+	if report.FirstTimeContributors[0].Count != 2 {
+		t.Errorf("unexpected first-timers %+v", report.FirstTimeContributors)
+	}
+	if report.ReviewLoad.MedianFirstReviewHours != 1.5 || report.ReviewLoad.Reviewers[0].Username != "ray" {
+		t.Errorf("unexpected review load %+v", report.ReviewLoad)
+	}
+	if report.BusFactor.Authors != 1 || report.BusFactor.TopAuthorShare != 0.8 {
+		t.Errorf("unexpected bus factor %+v", report.BusFactor)
+	}
+}
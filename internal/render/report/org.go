@@ -0,0 +1,102 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+type OrgReport struct {
+	SchemaVersion string           `json:"schemaVersion"`
+	GeneratedAt   time.Time        `json:"generatedAt"`
+	Range         RangeInfo        `json:"range"`
+	Name          string           `json:"name"`
+	Org           string           `json:"org,omitempty"`
+	Team          string           `json:"team,omitempty"`
+	MemberCount   int              `json:"memberCount"`
+	Stats         domain.StatsView `json:"stats"`
+	TotalScore    float64          `json:"totalScore"`
+	LifetimeScore float64          `json:"lifetimeScore"`
+	TotalEvents   int              `json:"totalEvents"`
+	Upstreams     []UpstreamImpact `json:"upstreams"`
+	Members       []MemberImpact   `json:"members"`
+	Scoring       *ScoringInfo     `json:"scoring,omitempty"`
+}
+
+type UpstreamImpact struct {
+	Repo        string   `json:"repo"`
+	RepoURL     string   `json:"repoURL"`
+	ImpactScore float64  `json:"impactScore"`
+	PRCount     int      `json:"prCount"`
+	Members     []string `json:"members"`
+}
+
+type MemberImpact struct {
+	Rank        int              `json:"rank"`
+	Username    string           `json:"username"`
+	ImpactScore float64          `json:"impactScore"`
+	Stats       domain.StatsView `json:"stats"`
+	TopRepos    []RepoImpact     `json:"topRepos"`
+}
+
+func (r Renderer) RenderOrgReport(ctx context.Context, org domain.OrgFootprint, generatedAt time.Time) ([]byte, error) {
+	_ = ctx
+
+	upstreams := make([]UpstreamImpact, 0, len(org.Upstreams))
+	for _, u := range org.Upstreams {
+		upstreams = append(upstreams, UpstreamImpact{
+			Repo:        u.Repo,
+			RepoURL:     u.RepoURL,
+			ImpactScore: u.Score,
+			PRCount:     u.PRsOpened,
+			Members:     u.Members,
+		})
+	}
+
+	members := make([]MemberImpact, 0, len(org.Members))
+	for i, m := range org.Members {
+		topRepos := make([]RepoImpact, 0, len(m.Repos))
+		for _, repo := range m.Repos {
+			topRepos = append(topRepos, RepoImpact{
+				Repo:        repo.Repo,
+				RepoURL:     repo.RepoURL,
+				ImpactScore: repo.Score,
+				PRCount:     repo.PRsOpened,
+			})
+		}
+		members = append(members, MemberImpact{
+			Rank:        i + 1,
+			Username:    m.User.Username,
+			ImpactScore: m.Score,
+			Stats:       m.Stats,
+			TopRepos:    topRepos,
+		})
+	}
+
+	report := OrgReport{
+		SchemaVersion: "1",
+		GeneratedAt:   generatedAt,
+		Range:         r.rangeInfo(),
+		Name:          org.Scope.Name,
+		Org:           org.Scope.Org,
+		Team:          org.Scope.Team,
+		MemberCount:   len(org.Members),
+		Stats:         org.Stats,
+		TotalScore:    org.TotalScore,
+		LifetimeScore: org.LifetimeScore,
+		TotalEvents:   org.Events,
+		Upstreams:     upstreams,
+		Members:       members,
+		Scoring:       r.scoringInfo(),
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling org report: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,48 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestRenderOrgReport_RanksMembers(t *testing.T) {
+	org := domain.OrgFootprint{
+		Scope:      domain.OrgScope{Org: "acme", Team: "platform", Name: "acme/platform"},
+		TotalScore: 50,
+		Events:     4,
+		Members: []domain.MemberFootprint{
+			{User: domain.User{Username: "ana"}, Score: 40, Repos: []domain.RepoContribution{{Repo: "up/stream", Score: 40, PRsOpened: 2}}},
+			{User: domain.User{Username: "bo"}, Score: 10},
+		},
+		Upstreams: []domain.UpstreamImpact{
+			{RepoContribution: domain.RepoContribution{Repo: "up/stream", Score: 50, PRsOpened: 3}, Members: []string{"ana", "bo"}},
+		},
+	}
+
+	out, err := Renderer{}.RenderOrgReport(context.Background(), org, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var report OrgReport
+	if err := json.Unmarshal(out, &report); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+
+	if report.Team != "platform" || report.MemberCount != 2 || report.TotalEvents != 4 {
+		t.Errorf("unexpected report header %+v", report)
+	}
+	if report.Members[0].Rank != 1 || report.Members[1].Rank != 2 || report.Members[1].Username != "bo" {
+		t.Errorf("expected members ranked in score order, got %+v", report.Members)
+	}
+	if len(report.Members[0].TopRepos) != 1 || report.Members[0].TopRepos[0].PRCount != 2 {
+		t.Errorf("expected member top repos, got %+v", report.Members[0].TopRepos)
+	}
+	if len(report.Upstreams) != 1 || len(report.Upstreams[0].Members) != 2 {
+		t.Errorf("expected upstream with both members, got %+v", report.Upstreams)
+	}
+}
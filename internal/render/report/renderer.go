@@ -10,47 +10,109 @@ import (
 	"github.com/arayofcode/footprint/internal/domain"
 )
 
-type Renderer struct{}
+type Renderer struct {
+	// Scoring, when set, is embedded with its hash so scores can be reproduced
+	Scoring *domain.ScoringConfig
+	Window  domain.TimeWindow // Period the report covers
+}
+
+// ScoringInfo records the weights a report was scored with.
+type ScoringInfo struct {
+	Hash   string               `json:"hash"`
+	Config domain.ScoringConfig `json:"config"`
+}
+
+func (r Renderer) scoringInfo() *ScoringInfo {
+	if r.Scoring == nil {
+		return nil
+	}
+	return &ScoringInfo{Hash: r.Scoring.Hash(), Config: *r.Scoring}
+}
+
+// RangeInfo is the period a report covers. Until is exclusive, and either
+// bound is omitted when open.
+type RangeInfo struct {
+	Label string `json:"label"`
+	domain.TimeWindow
+}
+
+func (r Renderer) rangeInfo() RangeInfo {
+	return RangeInfo{Label: r.Window.String(), TimeWindow: r.Window}
+}
+
+// ScoreTotals are the report's impact totals. Recent is weighted by recency
+// and equals Lifetime when recency weighting is off.
+type ScoreTotals struct {
+	Recent   float64 `json:"recent"`
+	Lifetime float64 `json:"lifetime"`
+}
+
+func scoreTotals(projects []domain.RepoContribution, ownedProjects []domain.OwnedProjectImpact, private *domain.PrivateContributions) ScoreTotals {
+	totals := domain.TotalScores(projects, ownedProjects, private)
+	return ScoreTotals{Recent: totals.Recent, Lifetime: totals.Lifetime}
+}
 
 type Report struct {
-	SchemaVersion  string                      `json:"schemaVersion"`
-	GeneratedAt    time.Time                   `json:"generatedAt"`
-	Username       string                      `json:"username"`
-	Stats          domain.StatsView            `json:"stats"`
-	TotalEvents    int                         `json:"totalEvents"`
-	EventsByType   map[string]int              `json:"eventsByType"`
-	Events         []domain.Contribution       `json:"events"`
-	OwnedProjects  []domain.OwnedProjectImpact `json:"ownedProjects"`
-	TopRepos       []RepoImpact                `json:"topRepos"`
-	ExternalPRsURL string                      `json:"externalPRsUrl"`
+	SchemaVersion  string                         `json:"schemaVersion"`
+	GeneratedAt    time.Time                      `json:"generatedAt"`
+	Range          RangeInfo                      `json:"range"`
+	Username       string                         `json:"username"`
+	Stats          domain.StatsView               `json:"stats"`
+	Totals         ScoreTotals                    `json:"totals"`
+	TotalEvents    int                            `json:"totalEvents"`
+	EventsByType   map[string]int                 `json:"eventsByType"`
+	ReactionTotals map[domain.ReactionContent]int `json:"reactionTotals"`
+	Events         []domain.Contribution          `json:"events"`
+	OwnedProjects  []domain.OwnedProjectImpact    `json:"ownedProjects"`
+	TopRepos       []RepoImpact                   `json:"topRepos"`
+	ExternalPRsURL string                         `json:"externalPRsUrl"`
+	Milestones     []domain.Milestone             `json:"milestones"`
+	Trend          *domain.Trend                  `json:"trend,omitempty"` // Change since an earlier run, when history is kept
+	Activity       domain.TimeSeries              `json:"activity"`        // Counts and lifetime scores by day, week and month
+	Languages      []domain.LanguageShare         `json:"languages"`       // Score by primary language, largest first
+	Scoring        *ScoringInfo                   `json:"scoring,omitempty"`
 }
 
 type RepoImpact struct {
-	Repo        string  `json:"repo"`
-	RepoURL     string  `json:"repoURL"`
-	ImpactScore float64 `json:"impactScore"`
-	PRCount     int     `json:"prCount"`
+	Repo          string  `json:"repo"`
+	RepoURL       string  `json:"repoURL"`
+	Language      string  `json:"language,omitempty"`
+	ImpactScore   float64 `json:"impactScore"`
+	BaseScore     float64 `json:"baseScore"`     // Before the popularity multiplier
+	ReleaseScore  float64 `json:"releaseScore"`  // Added after the multiplier
+	PopularityRaw float64 `json:"popularityRaw"` // Peak, before the cap
+	PRCount       int     `json:"prCount"`
+	TrivialPRs    int     `json:"trivialPRs,omitempty"` // PRs flagged trivial; see each event's Trivial
 }
 
-func (Renderer) RenderReport(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, projects []domain.RepoContribution, ownedProjects []domain.OwnedProjectImpact) ([]byte, error) {
+func (r Renderer) RenderReport(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, projects []domain.RepoContribution, ownedProjects []domain.OwnedProjectImpact, insights domain.Insights) ([]byte, error) {
 	_ = ctx
 
 	eventsByType := make(map[string]int)
+	reactionTotals := make(map[domain.ReactionContent]int)
 	var allFinalEvents []domain.Contribution
 	var topRepos []RepoImpact
 
 	for _, p := range projects {
 		repoURL := "https://github.com/" + p.Repo
 		topRepos = append(topRepos, RepoImpact{
-			Repo:        p.Repo,
-			RepoURL:     repoURL,
-			ImpactScore: p.Score,
-			PRCount:     p.PRsOpened,
+			Repo:          p.Repo,
+			RepoURL:       repoURL,
+			Language:      p.Language,
+			ImpactScore:   p.Score,
+			BaseScore:     p.BaseScore,
+			ReleaseScore:  p.ReleaseScore,
+			PopularityRaw: p.PopularityRaw,
+			PRCount:       p.PRsOpened,
+			TrivialPRs:    p.TrivialPRs,
 		})
 
 		for _, e := range p.Events {
 			allFinalEvents = append(allFinalEvents, e)
 			eventsByType[string(e.Type)]++
+			for content, n := range e.Reactions {
+				reactionTotals[content] += n
+			}
 		}
 	}
 
@@ -78,14 +140,22 @@ func (Renderer) RenderReport(ctx context.Context, user domain.User, stats domain
 	report := Report{
 		SchemaVersion:  "1",
 		GeneratedAt:    generatedAt,
+		Range:          r.rangeInfo(),
 		Username:       user.Username,
 		Stats:          stats,
+		Totals:         scoreTotals(projects, ownedProjects, stats.Private),
 		TotalEvents:    len(allFinalEvents),
 		EventsByType:   eventsByType,
+		ReactionTotals: reactionTotals,
 		Events:         allFinalEvents,
 		OwnedProjects:  ownedProjects,
 		TopRepos:       topRepos,
 		ExternalPRsURL: fmt.Sprintf("https://github.com/pulls?q=is:pr+author:%s+-user:%s", user.Username, user.Username),
+		Milestones:     insights.Milestones,
+		Trend:          insights.Trend,
+		Activity:       insights.Activity,
+		Languages:      insights.Languages,
+		Scoring:        r.scoringInfo(),
 	}
 
 	data, err := json.MarshalIndent(report, "", "  ")
@@ -95,3 +165,32 @@ func (Renderer) RenderReport(ctx context.Context, user domain.User, stats domain
 
 	return data, nil
 }
+
+// ReadBaseline reads what a previous report.json saw, for a digest of what
+// changed since.
+func ReadBaseline(data []byte) (domain.Baseline, error) {
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return domain.Baseline{}, fmt.Errorf("parsing report: %w", err)
+	}
+	baseline := domain.Baseline{
+		TakenAt:      report.GeneratedAt,
+		Score:        report.Totals.Recent,
+		Repos:        make(map[string]float64, len(report.TopRepos)+len(report.OwnedProjects)),
+		ProjectStars: make(map[string]int, len(report.OwnedProjects)),
+		MergedPRs:    make(map[string]bool),
+	}
+	for _, r := range report.TopRepos {
+		baseline.Repos[r.Repo] += r.ImpactScore
+	}
+	for _, p := range report.OwnedProjects {
+		baseline.Repos[p.Repo] += p.Score
+		baseline.ProjectStars[p.Repo] = p.Stars
+	}
+	for _, e := range report.Events {
+		if e.Type == domain.ContributionPR && e.Merged {
+			baseline.MergedPRs[e.URL] = true
+		}
+	}
+	return baseline, nil
+}
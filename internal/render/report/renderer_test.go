@@ -13,11 +13,14 @@ func TestRenderReport_BasicFields(t *testing.T) {
 	renderer := Renderer{}
 	projects := []domain.RepoContribution{
 		{
-			Repo:  "a/b",
-			Score: 10.5,
+			Repo:          "a/b",
+			Score:         10.5,
+			LifetimeScore: 10.5,
 			Events: []domain.Contribution{
-				{Type: domain.ContributionPR, Repo: "a/b", CreatedAt: time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC)},
-				{Type: domain.ContributionIssue, Repo: "a/b", CreatedAt: time.Date(2025, 2, 1, 11, 0, 0, 0, time.UTC)},
+				{Type: domain.ContributionPR, Repo: "a/b", CreatedAt: time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC),
+					Reactions: map[domain.ReactionContent]int{domain.ReactionRocket: 2}},
+				{Type: domain.ContributionIssue, Repo: "a/b", CreatedAt: time.Date(2025, 2, 1, 11, 0, 0, 0, time.UTC),
+					Reactions: map[domain.ReactionContent]int{domain.ReactionRocket: 1, domain.ReactionHeart: 4}},
 			},
 		},
 	}
@@ -27,7 +30,7 @@ func TestRenderReport_BasicFields(t *testing.T) {
 	generatedAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
 	user := domain.User{Username: "ray", AvatarURL: "https://avatar.com/ray"}
 
-	out, err := renderer.RenderReport(context.Background(), user, domain.StatsView{}, generatedAt, projects, ownedProjects)
+	out, err := renderer.RenderReport(context.Background(), user, domain.StatsView{}, generatedAt, projects, ownedProjects, domain.Insights{})
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -37,6 +40,10 @@ func TestRenderReport_BasicFields(t *testing.T) {
 		t.Fatalf("expected valid json, got %v", err)
 	}
 
+	if report.Totals.Recent != 14 || report.Totals.Lifetime != 14 {
+		t.Fatalf("expected totals of 14, got %+v", report.Totals)
+	}
+
 	if report.SchemaVersion != "1" {
 		t.Fatalf("expected schemaVersion 1, got %q", report.SchemaVersion)
 	}
@@ -55,16 +62,48 @@ func TestRenderReport_BasicFields(t *testing.T) {
 	if len(report.Events) != 2 {
 		t.Fatalf("expected 2 events, got %d", len(report.Events))
 	}
+	if report.ReactionTotals[domain.ReactionRocket] != 3 || report.ReactionTotals[domain.ReactionHeart] != 4 {
+		t.Fatalf("unexpected reactionTotals: %+v", report.ReactionTotals)
+	}
 	if len(report.OwnedProjects) != 1 {
 		t.Fatalf("expected 1 owned project, got %d", len(report.OwnedProjects))
 	}
 }
 
+func TestRenderReport_ShowsTrivialPRs(t *testing.T) {
+	projects := []domain.RepoContribution{{
+		Repo:       "a/b",
+		TrivialPRs: 1,
+		Events: []domain.Contribution{
+			{Type: domain.ContributionPR, Repo: "a/b", Trivial: []domain.TrivialReason{domain.TrivialDocsOnly}},
+		},
+	}}
+
+	out, err := Renderer{}.RenderReport(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, time.Now(), projects, nil, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var raw struct {
+		TopRepos []map[string]any `json:"topRepos"`
+		Events   []map[string]any `json:"events"`
+	}
+	if err := json.Unmarshal(out, &raw); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+	if raw.TopRepos[0]["trivialPRs"] != 1.0 {
+		t.Errorf("expected topRepos to count 1 trivial PR, got %v", raw.TopRepos[0])
+	}
+	if reasons, _ := raw.Events[0]["Trivial"].([]any); len(reasons) != 1 || reasons[0] != "docs-only" {
+		t.Errorf("expected the event to carry its trivial reasons, got %v", raw.Events[0])
+	}
+}
+
 func TestRenderReport_EventsByTypeEmptyWhenNoEvents(t *testing.T) {
 	renderer := Renderer{}
 	user := domain.User{Username: "ray"}
 
-	out, err := renderer.RenderReport(context.Background(), user, domain.StatsView{}, time.Now(), nil, nil)
+	out, err := renderer.RenderReport(context.Background(), user, domain.StatsView{}, time.Now(), nil, nil, domain.Insights{})
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -81,3 +120,185 @@ func TestRenderReport_EventsByTypeEmptyWhenNoEvents(t *testing.T) {
 		t.Fatalf("expected eventsByType empty, got %+v", report.EventsByType)
 	}
 }
+
+func TestRenderReport_IncludesMilestones(t *testing.T) {
+	renderer := Renderer{}
+	insights := domain.Insights{
+		Milestones: []domain.Milestone{
+			{Kind: domain.MilestoneFirstMergedPR, Repo: "a/b", AchievedAt: time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	out, err := renderer.RenderReport(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, time.Now(), nil, nil, insights)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var report Report
+	if err := json.Unmarshal(out, &report); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+	if len(report.Milestones) != 1 || report.Milestones[0].Kind != domain.MilestoneFirstMergedPR {
+		t.Fatalf("expected milestone in report, got %+v", report.Milestones)
+	}
+}
+
+func TestRenderReport_IncludesTrend(t *testing.T) {
+	render := func(insights domain.Insights) map[string]any {
+		out, err := Renderer{}.RenderReport(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, time.Now(), nil, nil, insights)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		var raw map[string]any
+		if err := json.Unmarshal(out, &raw); err != nil {
+			t.Fatalf("expected valid json, got %v", err)
+		}
+		return raw
+	}
+
+	if _, ok := render(domain.Insights{})["trend"]; ok {
+		t.Errorf("expected no trend without history")
+	}
+
+	raw := render(domain.Insights{Trend: &domain.Trend{Score: 34, NewRepos: []string{"new/repo"}}})
+	trend, ok := raw["trend"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected a trend object, got %v", raw["trend"])
+	}
+	if trend["score"] != 34.0 || trend["newRepos"].([]any)[0] != "new/repo" {
+		t.Errorf("expected score delta and new repos, got %v", trend)
+	}
+}
+
+func TestRenderReport_EmbedsScoringConfig(t *testing.T) {
+	config := domain.ScoringConfig{
+		BaseScores:        map[domain.ContributionType]float64{domain.ContributionTypePR: 10},
+		RepoMultiplierCap: 4,
+	}
+	renderer := Renderer{Scoring: &config}
+
+	out, err := renderer.RenderReport(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, time.Now(), nil, nil, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var report Report
+	if err := json.Unmarshal(out, &report); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+	if report.Scoring == nil || report.Scoring.Hash != config.Hash() {
+		t.Fatalf("expected scoring hash %s, got %+v", config.Hash(), report.Scoring)
+	}
+	if report.Scoring.Config.BaseScores[domain.ContributionTypePR] != 10 {
+		t.Fatalf("expected embedded base scores, got %+v", report.Scoring.Config)
+	}
+}
+
+func TestRenderReport_IncludesRange(t *testing.T) {
+	window := domain.TimeWindow{
+		Since: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	renderer := Renderer{Window: window}
+
+	out, err := renderer.RenderReport(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, time.Now(), nil, nil, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var report Report
+	if err := json.Unmarshal(out, &report); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+	if report.Range.Label != "2024" || !report.Range.Since.Equal(window.Since) || !report.Range.Until.Equal(window.Until) {
+		t.Fatalf("expected range 2024, got %+v", report.Range)
+	}
+}
+
+func TestReadBaseline_RoundTripsReport(t *testing.T) {
+	generatedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	projects := []domain.RepoContribution{{
+		Repo:  "a/b",
+		Score: 12,
+		Events: []domain.Contribution{
+			{Type: domain.ContributionPR, Repo: "a/b", URL: "https://github.com/a/b/pull/1", Merged: true},
+			{Type: domain.ContributionPR, Repo: "a/b", URL: "https://github.com/a/b/pull/2"},
+		},
+	}}
+	owned := []domain.OwnedProjectImpact{{Repo: "me/tool", Stars: 40, Score: 5}}
+
+	out, err := Renderer{}.RenderReport(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, generatedAt, projects, owned, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	baseline, err := ReadBaseline(out)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !baseline.TakenAt.Equal(generatedAt) || baseline.Score != 17 {
+		t.Errorf("expected time and total score, got %+v", baseline)
+	}
+	if baseline.Repos["a/b"] != 12 || baseline.Repos["me/tool"] != 5 || baseline.ProjectStars["me/tool"] != 40 {
+		t.Errorf("expected repo scores and project stars, got %+v", baseline)
+	}
+	if !baseline.MergedPRs["https://github.com/a/b/pull/1"] || baseline.MergedPRs["https://github.com/a/b/pull/2"] {
+		t.Errorf("expected only the merged PR to be recorded, got %v", baseline.MergedPRs)
+	}
+
+	if _, err := ReadBaseline([]byte("not json")); err == nil {
+		t.Errorf("expected an error for a malformed report")
+	}
+}
+
+func TestRenderReport_IncludesActivity(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	bucket := domain.TimeBucket{
+		Start:    start,
+		Activity: domain.Activity{Count: 2, Score: 30},
+		Types:    map[domain.SemanticEventType]domain.Activity{domain.SemanticEventPrOpened: {Count: 2, Score: 30}},
+		Repos:    map[string]domain.Activity{"a/b": {Count: 2, Score: 30}},
+	}
+	insights := domain.Insights{Activity: domain.TimeSeries{Month: []domain.TimeBucket{bucket}}}
+
+	out, err := Renderer{}.RenderReport(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, time.Now(), nil, nil, insights)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var raw struct {
+		Activity map[string][]map[string]any `json:"activity"`
+	}
+	if err := json.Unmarshal(out, &raw); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+	month := raw.Activity["month"]
+	if len(month) != 1 || month[0]["count"] != 2.0 || month[0]["score"] != 30.0 {
+		t.Fatalf("expected a flat count and score per bucket, got %v", raw.Activity)
+	}
+	if month[0]["types"].(map[string]any)["PR_OPENED"] == nil || month[0]["repos"].(map[string]any)["a/b"] == nil {
+		t.Errorf("expected per-type and per-repo splits, got %v", month[0])
+	}
+}
+
+func TestRenderReport_IncludesLanguages(t *testing.T) {
+	repos := []domain.RepoContribution{{Repo: "golang/go", Language: "Go", Score: 10}}
+	insights := domain.Insights{Languages: []domain.LanguageShare{{Language: "Go", Color: "#00ADD8", Score: 10, Share: 1, Repos: 1}}}
+
+	out, err := Renderer{}.RenderReport(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, time.Now(), repos, nil, insights)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var raw struct {
+		Languages []map[string]any `json:"languages"`
+		TopRepos  []RepoImpact     `json:"topRepos"`
+	}
+	if err := json.Unmarshal(out, &raw); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+	if len(raw.Languages) != 1 || raw.Languages[0]["language"] != "Go" || raw.Languages[0]["color"] != "#00ADD8" || raw.Languages[0]["share"] != 1.0 {
+		t.Errorf("expected the Go share, got %v", raw.Languages)
+	}
+	if len(raw.TopRepos) != 1 || raw.TopRepos[0].Language != "Go" {
+		t.Errorf("expected top repos to carry their language, got %+v", raw.TopRepos)
+	}
+}
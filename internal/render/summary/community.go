@@ -0,0 +1,78 @@
+package summary
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func (r Renderer) RenderCommunitySummary(ctx context.Context, community domain.CommunityFootprint, generatedAt time.Time) ([]byte, error) {
+	_ = ctx
+
+	var sb strings.Builder
+	stats := community.Stats
+	load := community.Reviews
+
+	title := community.Scope.Owner
+	if len(community.Scope.Repos) == 1 {
+		title = community.Scope.Repos[0]
+	}
+	fmt.Fprintf(&sb, "# Community Report: %s\n\n", title)
+	fmt.Fprintf(&sb, "*Generated on %s · Range: %s*\n\n", generatedAt.Format("January 2, 2006"), r.Window)
+
+	sb.WriteString("## Community Snapshot\n\n")
+	fmt.Fprintf(&sb, "- 👥 **%d** Contributors\n", len(community.Contributors))
+	fmt.Fprintf(&sb, "- 🔀 **%d** PRs Opened\n", stats.PRsOpened)
+	fmt.Fprintf(&sb, "- 📋 **%d** PR Reviews\n", stats.PRReviews)
+	fmt.Fprintf(&sb, "- 🐛 **%d** Issues Opened\n", stats.IssuesOpened)
+	sb.WriteString(impactLine("Community Impact", community.TotalScore, community.LifetimeScore))
+	sb.WriteString("\n")
+
+	sb.WriteString("## Top Contributors\n\n")
+	if len(community.Contributors) == 0 {
+		sb.WriteString("No outside contributors yet.\n")
+	}
+	for _, c := range community.Contributors {
+		fmt.Fprintf(&sb, "- [@%s](https://github.com/%s) · **%.1f** impact · %d PR(s) · %d review(s) · since %s\n",
+			c.User.Username, c.User.Username, c.Score, c.Stats.PRsOpened, c.Stats.PRReviews, c.FirstContribution.Format("Jan 2006"))
+	}
+	sb.WriteString("\n")
+
+	if len(community.FirstTimers) > 0 {
+		sb.WriteString("## First-Time Contributors\n\n")
+		for _, p := range community.FirstTimers {
+			fmt.Fprintf(&sb, "- %s: %d\n", p.Period, p.Count)
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("## Review Load\n\n")
+	fmt.Fprintf(&sb, "- %d of %d PR(s) reviewed by someone other than the author\n", load.Reviewed, load.PullRequests)
+	if load.Reviewed > 0 {
+		fmt.Fprintf(&sb, "- Median time to first review: %s\n", formatWait(load.MedianFirstReview))
+	}
+	fmt.Fprintf(&sb, "- %d of %d review(s) by the maintainer, %d community reviewer(s)\n", load.MaintainerReviews, load.Reviews, load.CommunityReviewers)
+	for _, reviewer := range load.Reviewers {
+		fmt.Fprintf(&sb, "- @%s · %d review(s) · %.0f%%\n", reviewer.User.Username, reviewer.Reviews, reviewer.Share*100)
+	}
+	sb.WriteString("\n")
+
+	bf := community.BusFactor
+	sb.WriteString("## Bus Factor\n\n")
+	fmt.Fprintf(&sb, "- **%d** author(s) wrote half of the merged PRs (top author: %.0f%%)\n", bf.Authors, bf.TopAuthorShare*100)
+	fmt.Fprintf(&sb, "- **%d** reviewer(s) did half of the reviews (top reviewer: %.0f%%)\n", bf.Reviewers, bf.TopReviewerShare*100)
+	sb.WriteString("\n")
+
+	return []byte(sb.String()), nil
+}
+
+// formatWait renders a duration in hours below two days, in days above.
+func formatWait(d time.Duration) string {
+	if d < 48*time.Hour {
+		return fmt.Sprintf("%.1f hours", d.Hours())
+	}
+	return fmt.Sprintf("%.1f days", d.Hours()/24)
+}
@@ -0,0 +1,45 @@
+package summary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestRenderCommunitySummary(t *testing.T) {
+	community := domain.CommunityFootprint{
+		Scope:         domain.CommunityScope{Owner: "ray", Repos: []string{"ray/tool", "ray/lib"}},
+		Stats:         domain.StatsView{PRsOpened: 3},
+		TotalScore:    30,
+		LifetimeScore: 30,
+		Contributors: []domain.CommunityContributor{
+			{MemberFootprint: domain.MemberFootprint{User: domain.User{Username: "ana"}, Stats: domain.StatsView{PRsOpened: 3}, Score: 30}, FirstContribution: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		FirstTimers: []domain.PeriodCount{{Period: "2024-03", Count: 1}},
+		Reviews: domain.ReviewLoad{
+			PullRequests:      4,
+			Reviewed:          2,
+			Reviews:           3,
+			MedianFirstReview: 6 * time.Hour,
+			MaintainerReviews: 2,
+			Reviewers:         []domain.ReviewerLoad{{User: domain.User{Username: "ray"}, Reviews: 2, Share: 2.0 / 3}},
+		},
+		BusFactor: domain.BusFactor{Authors: 1, Reviewers: 1, TopAuthorShare: 0.5, TopReviewerShare: 2.0 / 3},
+	}
+
+	out, err := Renderer{}.RenderCommunitySummary(context.Background(), community, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content := string(out)
+	assertContains(t, content, "# Community Report: ray")
+	assertContains(t, content, "- [@ana](https://github.com/ana) · **30.0** impact · 3 PR(s) · 0 review(s) · since Mar 2024")
+	assertContains(t, content, "- 2024-03: 1")
+	assertContains(t, content, "- 2 of 4 PR(s) reviewed by someone other than the author")
+	assertContains(t, content, "- Median time to first review: 6.0 hours")
+	assertContains(t, content, "- @ray · 2 review(s) · 67%")
+	assertContains(t, content, "- **1** author(s) wrote half of the merged PRs (top author: 50%)")
+}
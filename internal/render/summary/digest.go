@@ -0,0 +1,131 @@
+package summary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// digestMovers is how many repo score changes a digest lists.
+const digestMovers = 5
+
+// RenderDigest renders what changed since the previous run as Markdown, for
+// a team channel rather than the full summary.
+func (r Renderer) RenderDigest(ctx context.Context, user domain.User, generatedAt time.Time, digest domain.Digest) ([]byte, error) {
+	_ = ctx
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# What's New: @%s\n\n", user.Username)
+	if digest.Since.IsZero() {
+		fmt.Fprintf(&sb, "*Generated on %s*\n\n", generatedAt.Format("January 2, 2006"))
+		fmt.Fprintf(&sb, "First run, so there is nothing to compare with yet. Total impact is **%.1f**.\n", digest.Score)
+		return []byte(sb.String()), nil
+	}
+	fmt.Fprintf(&sb, "*Since %s · Generated on %s*\n\n", digest.Since.Format("January 2, 2006"), generatedAt.Format("January 2, 2006"))
+	fmt.Fprintf(&sb, "%s Total impact **%.1f** (%+.1f)\n\n", trendIcon(digest.ScoreDelta), digest.Score, digest.ScoreDelta)
+
+	if digest.IsEmpty() {
+		sb.WriteString("Nothing new since the last run.\n")
+		return []byte(sb.String()), nil
+	}
+
+	if len(digest.MergedPRs) > 0 {
+		sb.WriteString("## Merged PRs\n\n")
+		for _, pr := range digest.MergedPRs {
+			fmt.Fprintf(&sb, "- ✅ [%s](%s) · `%s`\n", pr.Title, pr.URL, pr.Repo)
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(digest.NewRepos) > 0 {
+		sb.WriteString("## New Repositories\n\n")
+		for _, repo := range digest.NewRepos {
+			fmt.Fprintf(&sb, "- 🆕 [%s](https://github.com/%s)\n", repo, repo)
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(digest.NewStars) > 0 {
+		sb.WriteString("## New Stars\n\n")
+		for _, s := range digest.NewStars {
+			fmt.Fprintf(&sb, "- ⭐ [%s](%s) · +%d (%s total)\n", s.Repo, s.URL, s.Gained, formatLargeNum(s.Stars))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(digest.Repos) > 0 {
+		sb.WriteString("## Score Changes\n\n")
+		for _, d := range digest.Repos[:min(len(digest.Repos), digestMovers)] {
+			fmt.Fprintf(&sb, "- `%s` %+.1f\n", d.Repo, d.Score)
+		}
+		sb.WriteString("\n")
+	}
+
+	return []byte(sb.String()), nil
+}
+
+// DigestPayload is a chat webhook body. Text is what Slack, Mattermost,
+// Google Chat and similar incoming webhooks post; Digest carries the same
+// changes for bots that format their own message.
+type DigestPayload struct {
+	Text   string        `json:"text"`
+	User   string        `json:"user"`
+	Digest domain.Digest `json:"digest"`
+}
+
+// RenderDigestPayload renders the digest as a JSON webhook payload. Its text
+// is plain, with bare URLs, so it reads the same in every chat client.
+func (r Renderer) RenderDigestPayload(ctx context.Context, user domain.User, generatedAt time.Time, digest domain.Digest) ([]byte, error) {
+	_ = ctx
+
+	payload := DigestPayload{
+		Text:   digestText(user, digest),
+		User:   user.Username,
+		Digest: digest,
+	}
+	data, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling digest payload: %w", err)
+	}
+	return data, nil
+}
+
+// digestText is the one-message form of a digest, e.g. "What's new for @ray
+// since Mar 1: +34.0 score (120.0), 2 merged PRs, 1 new repo".
+func digestText(user domain.User, digest domain.Digest) string {
+	if digest.Since.IsZero() {
+		return fmt.Sprintf("Footprint for @%s: total impact %.1f. Changes will be reported from the next run.", user.Username, digest.Score)
+	}
+
+	headline := []string{fmt.Sprintf("%+.1f score (%.1f)", digest.ScoreDelta, digest.Score)}
+	if n := len(digest.MergedPRs); n > 0 {
+		headline = append(headline, fmt.Sprintf("%d merged PR(s)", n))
+	}
+	if n := len(digest.NewRepos); n > 0 {
+		headline = append(headline, fmt.Sprintf("%d new repo(s)", n))
+	}
+	var stars int
+	for _, s := range digest.NewStars {
+		stars += s.Gained
+	}
+	if stars > 0 {
+		headline = append(headline, fmt.Sprintf("+%d star(s)", stars))
+	}
+
+	lines := []string{fmt.Sprintf("What's new for @%s since %s: %s", user.Username, digest.Since.Format("Jan 2"), strings.Join(headline, ", "))}
+	for _, pr := range digest.MergedPRs {
+		lines = append(lines, fmt.Sprintf("• Merged: %s (%s) %s", pr.Title, pr.Repo, pr.URL))
+	}
+	for _, repo := range digest.NewRepos {
+		lines = append(lines, fmt.Sprintf("• New repo: %s https://github.com/%s", repo, repo))
+	}
+	for _, s := range digest.NewStars {
+		lines = append(lines, fmt.Sprintf("• %s: +%d star(s), %s total", s.Repo, s.Gained, formatLargeNum(s.Stars)))
+	}
+	return strings.Join(lines, "\n")
+}
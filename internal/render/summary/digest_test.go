@@ -0,0 +1,78 @@
+package summary
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func testDigest() domain.Digest {
+	return domain.Digest{
+		Since:      time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		MergedPRs:  []domain.DigestPR{{Repo: "a/b", Title: "Fix parser", URL: "https://github.com/a/b/pull/7"}},
+		NewRepos:   []string{"new/repo"},
+		NewStars:   []domain.StarGain{{Repo: "me/tool", URL: "https://github.com/me/tool", Stars: 1200, Gained: 5}},
+		Score:      120,
+		ScoreDelta: 34,
+		Repos:      []domain.RepoDelta{{Repo: "a/b", Score: 24}},
+	}
+}
+
+func TestRenderDigest(t *testing.T) {
+	generatedAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	out, err := Renderer{}.RenderDigest(context.Background(), domain.User{Username: "ray"}, generatedAt, testDigest())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	content := string(out)
+	assertContains(t, content, "# What's New: @ray")
+	assertContains(t, content, "*Since March 1, 2026 · Generated on March 8, 2026*")
+	assertContains(t, content, "📈 Total impact **120.0** (+34.0)")
+	assertContains(t, content, "- ✅ [Fix parser](https://github.com/a/b/pull/7) · `a/b`")
+	assertContains(t, content, "- 🆕 [new/repo](https://github.com/new/repo)")
+	assertContains(t, content, "- ⭐ [me/tool](https://github.com/me/tool) · +5 (1.2k total)")
+	assertContains(t, content, "- `a/b` +24.0")
+}
+
+func TestRenderDigest_FirstAndQuietRuns(t *testing.T) {
+	user := domain.User{Username: "ray"}
+
+	out, err := Renderer{}.RenderDigest(context.Background(), user, time.Now(), domain.Digest{Score: 12})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	assertContains(t, string(out), "First run, so there is nothing to compare with yet. Total impact is **12.0**.")
+
+	quiet := domain.Digest{Since: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Score: 12}
+	out, err = Renderer{}.RenderDigest(context.Background(), user, time.Now(), quiet)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	assertContains(t, string(out), "Nothing new since the last run.")
+}
+
+func TestRenderDigestPayload(t *testing.T) {
+	out, err := Renderer{}.RenderDigestPayload(context.Background(), domain.User{Username: "ray"}, time.Now(), testDigest())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var payload DigestPayload
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+	lines := strings.Split(payload.Text, "\n")
+	if lines[0] != "What's new for @ray since Mar 1: +34.0 score (120.0), 1 merged PR(s), 1 new repo(s), +5 star(s)" {
+		t.Errorf("unexpected headline %q", lines[0])
+	}
+	if len(lines) != 4 || lines[1] != "• Merged: Fix parser (a/b) https://github.com/a/b/pull/7" {
+		t.Errorf("expected one line per change, got %q", payload.Text)
+	}
+	if payload.User != "ray" || len(payload.Digest.MergedPRs) != 1 {
+		t.Errorf("expected the structured digest alongside the text, got %+v", payload)
+	}
+}
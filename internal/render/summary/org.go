@@ -0,0 +1,52 @@
+package summary
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func (r Renderer) RenderOrgSummary(ctx context.Context, org domain.OrgFootprint, generatedAt time.Time) ([]byte, error) {
+	_ = ctx
+
+	var sb strings.Builder
+	stats := org.Stats
+
+	fmt.Fprintf(&sb, "# OSS Footprint: %s\n\n", org.Scope.Name)
+	fmt.Fprintf(&sb, "*Generated on %s · Range: %s*\n\n", generatedAt.Format("January 2, 2006"), r.Window)
+
+	sb.WriteString("## Impact Snapshot\n\n")
+	fmt.Fprintf(&sb, "- 👥 **%d** Members\n", len(org.Members))
+	fmt.Fprintf(&sb, "- 🌍 **%d** Upstream Repositories\n", len(org.Upstreams))
+	fmt.Fprintf(&sb, "- 🔀 **%d** PRs Opened\n", stats.PRsOpened)
+	fmt.Fprintf(&sb, "- 📋 **%d** PR Reviews\n", stats.PRReviews+stats.PRReviewComments)
+	fmt.Fprintf(&sb, "- 🐛 **%d** Issues Opened\n", stats.IssuesOpened)
+	fmt.Fprintf(&sb, "- 💬 **%d** Issue Comments\n", stats.IssueComments)
+	sb.WriteString(impactLine("Total Impact", org.TotalScore, org.LifetimeScore))
+	sb.WriteString("\n")
+
+	sb.WriteString("## Top Upstreams\n\n")
+	for _, u := range org.Upstreams {
+		fmt.Fprintf(&sb, "- [`%s`](%s) · **%.1f** impact · %d PR(s) · %s\n", u.Repo, u.RepoURL, u.Score, u.PRsOpened, formatMembers(u.Members))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Members\n\n")
+	for _, m := range org.Members {
+		fmt.Fprintf(&sb, "- [@%s](https://github.com/%s) · **%.1f** impact · %d PR(s) · %d repo(s)\n", m.User.Username, m.User.Username, m.Score, m.Stats.PRsOpened, len(m.Repos))
+	}
+	sb.WriteString("\n")
+
+	return []byte(sb.String()), nil
+}
+
+func formatMembers(usernames []string) string {
+	mentions := make([]string, len(usernames))
+	for i, u := range usernames {
+		mentions[i] = "@" + u
+	}
+	return strings.Join(mentions, ", ")
+}
@@ -0,0 +1,35 @@
+package summary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestRenderOrgSummary(t *testing.T) {
+	org := domain.OrgFootprint{
+		Scope:         domain.OrgScope{Org: "acme", Name: "acme"},
+		Stats:         domain.StatsView{PRsOpened: 3},
+		TotalScore:    42,
+		LifetimeScore: 42,
+		Members: []domain.MemberFootprint{
+			{User: domain.User{Username: "ana"}, Stats: domain.StatsView{PRsOpened: 3}, Score: 42, Repos: []domain.RepoContribution{{Repo: "up/stream"}}},
+		},
+		Upstreams: []domain.UpstreamImpact{
+			{RepoContribution: domain.RepoContribution{Repo: "up/stream", RepoURL: "https://github.com/up/stream", Score: 42, PRsOpened: 3}, Members: []string{"ana"}},
+		},
+	}
+
+	out, err := Renderer{}.RenderOrgSummary(context.Background(), org, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content := string(out)
+	assertContains(t, content, "# OSS Footprint: acme")
+	assertContains(t, content, "- 👥 **1** Members")
+	assertContains(t, content, "- [`up/stream`](https://github.com/up/stream) · **42.0** impact · 3 PR(s) · @ana")
+	assertContains(t, content, "- [@ana](https://github.com/ana) · **42.0** impact · 3 PR(s) · 1 repo(s)")
+}
@@ -3,6 +3,7 @@ package summary
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"time"
@@ -10,15 +11,17 @@ import (
 	"github.com/arayofcode/footprint/internal/domain"
 )
 
-type Renderer struct{}
+type Renderer struct {
+	Window domain.TimeWindow // Period the summary covers, shown in the header
+}
 
-func (Renderer) RenderSummary(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, projects []domain.RepoContribution, ownedProjects []domain.OwnedProjectImpact) ([]byte, error) {
+func (r Renderer) RenderSummary(ctx context.Context, user domain.User, stats domain.StatsView, generatedAt time.Time, projects []domain.RepoContribution, ownedProjects []domain.OwnedProjectImpact, insights domain.Insights) ([]byte, error) {
 	_ = ctx
 
 	var sb strings.Builder
 
 	fmt.Fprintf(&sb, "# OSS Footprint: @%s\n\n", user.Username)
-	fmt.Fprintf(&sb, "*Generated on %s*\n\n", generatedAt.Format("January 2, 2006"))
+	fmt.Fprintf(&sb, "*Generated on %s · Range: %s*\n\n", generatedAt.Format("January 2, 2006"), r.Window)
 
 	sb.WriteString("## Impact Snapshot\n\n")
 	fmt.Fprintf(&sb, "- 🔀 **%d** PRs Opened\n", stats.PRsOpened)
@@ -26,9 +29,36 @@ func (Renderer) RenderSummary(ctx context.Context, user domain.User, stats domai
 	fmt.Fprintf(&sb, "- 🐛 **%d** Issues Opened\n", stats.IssuesOpened)
 	fmt.Fprintf(&sb, "- 💬 **%d** Issue Comments\n", stats.IssueComments)
 	fmt.Fprintf(&sb, "- 📦 **%d** Projects Owned\n", stats.ProjectsOwned)
-	fmt.Fprintf(&sb, "- ⭐ **%s** Stars Earned\n\n", formatLargeNum(stats.StarsEarned))
+	fmt.Fprintf(&sb, "- ⭐ **%s** Stars Earned\n", formatLargeNum(stats.StarsEarned))
+	totals := domain.TotalScores(projects, ownedProjects, stats.Private)
+	sb.WriteString(impactLine("Total Impact", totals.Recent, totals.Lifetime))
+	if stats.ReleasesPublished > 0 {
+		fmt.Fprintf(&sb, "- 🏷️ **%d** Releases Published\n", stats.ReleasesPublished)
+	}
+	if stats.TriageActions > 0 {
+		fmt.Fprintf(&sb, "- 🗂️ **%d** Triage Actions\n", stats.TriageActions)
+	}
+	if stats.SecurityAdvisories > 0 {
+		fmt.Fprintf(&sb, "- 🛡️ **%d** Security Advisories Credited\n", stats.SecurityAdvisories)
+	}
+	if stats.Private != nil {
+		fmt.Fprintf(&sb, "- 🔒 **%s** Private Contributions *(aggregate only)*\n", formatLargeNum(stats.Private.Total()))
+	}
+	sb.WriteString("\n")
 	fmt.Fprintf(&sb, "[View all external PRs authored by @%s](https://github.com/pulls?q=is%%3Apr+author%%3A%s+-user%%3A%s)\n\n", user.Username, user.Username, user.Username)
 
+	if insights.Trend != nil {
+		sb.WriteString(formatTrend(*insights.Trend, generatedAt))
+	}
+
+	if len(insights.Milestones) > 0 {
+		sb.WriteString("## Milestones\n\n")
+		for _, m := range insights.Milestones {
+			sb.WriteString(formatMilestone(m))
+		}
+		sb.WriteString("\n")
+	}
+
 	if len(ownedProjects) > 0 {
 		sb.WriteString("## Owned Projects\n\n")
 		sort.Slice(ownedProjects, func(i, j int) bool {
@@ -65,8 +95,18 @@ func (Renderer) RenderSummary(ctx context.Context, user domain.User, stats domai
 		})
 
 		for _, event := range events {
+			// Triage actions are too numerous to list; they're summarized below
+			if event.Type == domain.ContributionTriage {
+				continue
+			}
 			sb.WriteString(formatOutputEvent(event))
 		}
+		if p.TriageActions > 0 {
+			fmt.Fprintf(&sb, "- 🗂️ %d triage action(s) (labels, closes, duplicates, review requests)\n", p.TriageActions)
+		}
+		if p.TrivialPRs > 0 {
+			fmt.Fprintf(&sb, "- 🪶 %d PR(s) flagged trivial and scored at a reduced base: %s\n", p.TrivialPRs, countTrivialReasons(events))
+		}
 		sb.WriteString("\n")
 	}
 
@@ -92,24 +132,235 @@ func formatOutputEvent(event domain.Contribution) string {
 		icon = "💡"
 	case domain.ContributionDiscussionComment:
 		icon = "🗨️"
+	case domain.ContributionRelease:
+		icon = "🏷️"
+	case domain.ContributionSecurityAdvisory:
+		icon = "🛡️"
 	default:
 		icon = "📝"
 	}
 
 	line := fmt.Sprintf("- %s **[%s](%s)** (%s)", icon, event.Title, event.URL, date)
 
-	if event.ReactionsCount > 0 {
-		line += fmt.Sprintf(" · ❤️ %d", event.ReactionsCount)
+	if reactions := formatReactions(event.Reactions); reactions != "" {
+		line += " · " + reactions
+	} else if event.ReactionsCount > 0 {
+		line += fmt.Sprintf(" · %d reaction(s)", event.ReactionsCount)
 	}
 
 	if event.Merged {
 		line += " · ✅ Merged"
 	}
 
+	if a := event.Advisory; a != nil {
+		line += " · " + formatAdvisory(*a)
+	}
+
+	if len(event.Trivial) > 0 {
+		reasons := make([]string, len(event.Trivial))
+		for i, r := range event.Trivial {
+			reasons[i] = string(r)
+		}
+		line += " · 🪶 Trivial (" + strings.Join(reasons, ", ") + ")"
+	}
+
 	line += "\n"
+
+	for _, issue := range event.ResolvedIssues {
+		line += fmt.Sprintf("  - ↳ Resolves [%s](%s)", issue.Title, issue.URL)
+		if issue.ReactionsCount > 0 {
+			line += fmt.Sprintf(" · %d reaction(s)", issue.ReactionsCount)
+		}
+		if age := issue.OpenFor(event.CreatedAt); age > 0 {
+			line += " · open " + formatAge(age)
+		}
+		line += "\n"
+	}
+
 	return line
 }
 
+var reactionEmoji = map[domain.ReactionContent]string{
+	domain.ReactionThumbsUp:   "👍",
+	domain.ReactionThumbsDown: "👎",
+	domain.ReactionLaugh:      "😄",
+	domain.ReactionHooray:     "🎉",
+	domain.ReactionConfused:   "😕",
+	domain.ReactionHeart:      "❤️",
+	domain.ReactionRocket:     "🚀",
+	domain.ReactionEyes:       "👀",
+}
+
+// formatReactions renders the per-reaction breakdown in GitHub's display order.
+func formatReactions(reactions map[domain.ReactionContent]int) string {
+	var parts []string
+	for _, content := range domain.ReactionContents {
+		if n := reactions[content]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", reactionEmoji[content], n))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatAdvisory renders severity, ecosystems and the user's credit role,
+// e.g. "HIGH · npm · reporter".
+func formatAdvisory(a domain.AdvisoryCredit) string {
+	var parts []string
+	if a.Severity != "" {
+		parts = append(parts, string(a.Severity))
+	}
+	if len(a.Ecosystems) > 0 {
+		parts = append(parts, strings.Join(a.Ecosystems, ", "))
+	}
+	if a.CreditType != "" {
+		parts = append(parts, strings.ReplaceAll(a.CreditType, "_", " "))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// countTrivialReasons tallies the signals that flagged trivial PRs, e.g.
+// "docs-only ×2, title ×1".
+func countTrivialReasons(events []domain.Contribution) string {
+	counts := make(map[domain.TrivialReason]int)
+	for _, e := range events {
+		for _, r := range e.Trivial {
+			counts[r]++
+		}
+	}
+	var parts []string
+	for _, r := range domain.TrivialReasons {
+		if n := counts[r]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s ×%d", r, n))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// trendWeek is the baseline age a trend is labelled "this week" for; daily
+// and weekly runs compare against a snapshot about this old.
+const trendWeek = 7 * 24 * time.Hour
+
+// trendMovers is how many changed repos the trend section lists.
+const trendMovers = 3
+
+// formatTrend renders the change since the last history snapshot, e.g.
+// "+34.0 score this week, new repo: X".
+func formatTrend(t domain.Trend, generatedAt time.Time) string {
+	var sb strings.Builder
+	period := "since " + t.Since.Format("Jan 2, 2006")
+	if age := generatedAt.Sub(t.Since); age >= trendWeek && age < 2*trendWeek {
+		period = "this week"
+	}
+
+	sb.WriteString("## Trend\n\n")
+	headline := fmt.Sprintf("- %s **%+.1f** score %s", trendIcon(t.Score), t.Score, period)
+	switch len(t.NewRepos) {
+	case 0:
+	case 1:
+		headline += fmt.Sprintf(", new repo: [%s](https://github.com/%s)", t.NewRepos[0], t.NewRepos[0])
+	default:
+		headline += fmt.Sprintf(", %d new repos", len(t.NewRepos))
+	}
+	sb.WriteString(headline + "\n")
+
+	if len(t.NewRepos) > 1 {
+		links := make([]string, len(t.NewRepos))
+		for i, repo := range t.NewRepos {
+			links[i] = fmt.Sprintf("[%s](https://github.com/%s)", repo, repo)
+		}
+		fmt.Fprintf(&sb, "- 🆕 New repos: %s\n", strings.Join(links, ", "))
+	}
+
+	var stats []string
+	for _, s := range []struct {
+		icon, label string
+		delta       int
+	}{
+		{"🔀", "PRs", t.Stats.PRsOpened},
+		{"📋", "reviews", t.Stats.PRReviews + t.Stats.PRReviewComments},
+		{"🐛", "issues", t.Stats.IssuesOpened},
+		{"💬", "comments", t.Stats.IssueComments},
+		{"📦", "projects", t.Stats.ProjectsOwned},
+		{"⭐", "stars", t.Stats.StarsEarned},
+	} {
+		if s.delta != 0 {
+			stats = append(stats, fmt.Sprintf("%s %+d %s", s.icon, s.delta, s.label))
+		}
+	}
+	if len(stats) > 0 {
+		fmt.Fprintf(&sb, "- %s\n", strings.Join(stats, " · "))
+	}
+
+	if len(t.Repos) > 0 {
+		movers := t.Repos[:min(len(t.Repos), trendMovers)]
+		parts := make([]string, len(movers))
+		for i, r := range movers {
+			parts[i] = fmt.Sprintf("`%s` %+.1f", r.Repo, r.Score)
+		}
+		fmt.Fprintf(&sb, "- Biggest changes: %s\n", strings.Join(parts, " · "))
+	}
+
+	if t.ScoringChanged {
+		sb.WriteString("- ⚖️ *Scoring weights changed since then, so part of the score change is reweighting*\n")
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+func trendIcon(delta float64) string {
+	if delta < 0 {
+		return "📉"
+	}
+	return "📈"
+}
+
+func formatAge(d time.Duration) string {
+	days := int(d.Hours() / 24)
+	switch {
+	case days < 60:
+		return fmt.Sprintf("%d day(s)", days)
+	case days < 730:
+		return fmt.Sprintf("%d months", days/30)
+	default:
+		return fmt.Sprintf("%d years", days/365)
+	}
+}
+
+func formatMilestone(m domain.Milestone) string {
+	date := m.AchievedAt.Format("Jan 2, 2006")
+
+	switch m.Kind {
+	case domain.MilestoneFirstMergedPR:
+		line := fmt.Sprintf("- 🎉 First merged PR to **[%s](https://github.com/%s)**: [%s](%s) (%s)", m.Repo, m.Repo, m.Title, m.URL, date)
+		if m.FirstTimer {
+			line += " · 🌱 First-time contributor"
+		}
+		return line + "\n"
+	case domain.MilestoneFirstPopularRepo:
+		return fmt.Sprintf("- 🌟 First contribution to a %s★ repo: **[%s](%s)** (%s)\n", formatThreshold(m.Threshold), m.Repo, m.URL, date)
+	case domain.MilestoneNewReposThisYear:
+		return fmt.Sprintf("- 🆕 **%d** new repo(s) contributed to in %d\n", m.Count, m.Year)
+	default:
+		return ""
+	}
+}
+
+func formatThreshold(n int) string {
+	if n >= 1000 && n%1000 == 0 {
+		return fmt.Sprintf("%dk", n/1000)
+	}
+	return formatLargeNum(n)
+}
+
+// impactLine shows a recency-weighted total next to the lifetime one, or a
+// single total when they agree.
+func impactLine(label string, recent, lifetime float64) string {
+	if math.Abs(recent-lifetime) < 0.05 {
+		return fmt.Sprintf("- 📈 **%.1f** %s\n", recent, label)
+	}
+	return fmt.Sprintf("- 📈 **%.1f** %s (recent) · **%.1f** lifetime\n", recent, label, lifetime)
+}
+
 func formatLargeNum(n int) string {
 	if n >= 1000 {
 		return fmt.Sprintf("%.1fk", float64(n)/1000.0)
@@ -27,14 +27,14 @@ func TestRenderSummary_IncludesHeaderAndTotals(t *testing.T) {
 	generatedAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
 	user := domain.User{Username: "ray"}
 
-	out, err := renderer.RenderSummary(context.Background(), user, domain.StatsView{PRsOpened: 1, ProjectsOwned: 1, IssuesOpened: 1}, generatedAt, projects, ownedProjects)
+	out, err := renderer.RenderSummary(context.Background(), user, domain.StatsView{PRsOpened: 1, ProjectsOwned: 1, IssuesOpened: 1}, generatedAt, projects, ownedProjects, domain.Insights{})
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
 	content := string(out)
 	assertContains(t, content, "# OSS Footprint: @ray")
-	assertContains(t, content, "Generated on February 1, 2025")
+	assertContains(t, content, "Generated on February 1, 2025 · Range: All-time")
 	assertContains(t, content, "## Impact Snapshot")
 	assertContains(t, content, "🔀 **1** PRs Opened")
 	assertContains(t, content, "🐛 **1** Issues Opened")
@@ -69,7 +69,7 @@ func TestRenderSummary_FormatsRepositorySection(t *testing.T) {
 	}
 	user := domain.User{Username: "ray"}
 
-	out, err := renderer.RenderSummary(context.Background(), user, domain.StatsView{}, time.Now(), projects, nil)
+	out, err := renderer.RenderSummary(context.Background(), user, domain.StatsView{}, time.Now(), projects, nil, domain.Insights{})
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -95,27 +95,249 @@ func TestRenderSummary_IncludesReactionsAndMergedFlags(t *testing.T) {
 					URL:            "https://github.com/a/b/pull/1",
 					Title:          "Add feature",
 					CreatedAt:      time.Now(),
-					ReactionsCount: 3,
-					Merged:         true,
+					ReactionsCount: 5,
+					Reactions: map[domain.ReactionContent]int{
+						domain.ReactionHeart:    3,
+						domain.ReactionThumbsUp: 2,
+					},
+					Merged: true,
 				},
 			},
 		},
 	}
 	user := domain.User{Username: "ray"}
 
-	out, err := renderer.RenderSummary(context.Background(), user, domain.StatsView{}, time.Now(), projects, nil)
+	out, err := renderer.RenderSummary(context.Background(), user, domain.StatsView{}, time.Now(), projects, nil, domain.Insights{})
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
 	content := string(out)
-	assertContains(t, content, "❤️ 3")
+	assertContains(t, content, "👍 2 ❤️ 3")
 	assertContains(t, content, "✅ Merged")
 }
 
+func TestRenderSummary_ListsResolvedIssuesUnderPR(t *testing.T) {
+	renderer := Renderer{}
+	prCreated := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	projects := []domain.RepoContribution{
+		{
+			Repo: "a/b",
+			Events: []domain.Contribution{
+				{
+					Type:      domain.ContributionPR,
+					Repo:      "a/b",
+					URL:       "https://github.com/a/b/pull/9",
+					Title:     "Fix crash",
+					CreatedAt: prCreated,
+					Merged:    true,
+					ResolvedIssues: []domain.LinkedIssue{
+						{Title: "Crash on start", URL: "https://github.com/a/b/issues/1", CreatedAt: prCreated.AddDate(-3, 0, 0), ReactionsCount: 12},
+					},
+				},
+			},
+		},
+	}
+
+	out, err := renderer.RenderSummary(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, time.Now(), projects, nil, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	assertContains(t, string(out), "  - ↳ Resolves [Crash on start](https://github.com/a/b/issues/1) · 12 reaction(s) · open 3 years")
+}
+
+func TestRenderSummary_IncludesSecurityAdvisoryCredits(t *testing.T) {
+	renderer := Renderer{}
+	projects := []domain.RepoContribution{
+		{
+			Repo:               "a/b",
+			SecurityAdvisories: 1,
+			Events: []domain.Contribution{
+				{
+					Type:      domain.ContributionSecurityAdvisory,
+					Repo:      "a/b",
+					URL:       "https://github.com/a/b/security/advisories/GHSA-1234",
+					Title:     "Path traversal in loader",
+					CreatedAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+					Advisory: &domain.AdvisoryCredit{
+						GHSAID:     "GHSA-1234",
+						Severity:   domain.AdvisorySeverityHigh,
+						Ecosystems: []string{"npm"},
+						CreditType: "remediation_developer",
+					},
+				},
+			},
+		},
+	}
+
+	out, err := renderer.RenderSummary(context.Background(), domain.User{Username: "ray"}, domain.StatsView{SecurityAdvisories: 1}, time.Now(), projects, nil, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content := string(out)
+	assertContains(t, content, "- 🛡️ **1** Security Advisories Credited")
+	assertContains(t, content, "- 🛡️ **[Path traversal in loader](https://github.com/a/b/security/advisories/GHSA-1234)** (Mar 1, 2025) · HIGH · npm · remediation developer")
+}
+
+func TestRenderSummary_IncludesReleases(t *testing.T) {
+	renderer := Renderer{}
+	projects := []domain.RepoContribution{
+		{
+			Repo:     "a/b",
+			Releases: 1,
+			Events: []domain.Contribution{
+				{Type: domain.ContributionRelease, Repo: "a/b", URL: "https://github.com/a/b/releases/tag/v1.2.0", Title: "v1.2.0", CreatedAt: time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	out, err := renderer.RenderSummary(context.Background(), domain.User{Username: "ray"}, domain.StatsView{ReleasesPublished: 1}, time.Now(), projects, nil, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content := string(out)
+	assertContains(t, content, "- 🏷️ **1** Releases Published")
+	assertContains(t, content, "- 🏷️ **[v1.2.0](https://github.com/a/b/releases/tag/v1.2.0)** (Apr 2, 2025)")
+}
+
+func TestRenderSummary_SummarizesTriageActionsPerRepo(t *testing.T) {
+	renderer := Renderer{}
+	projects := []domain.RepoContribution{
+		{
+			Repo:          "a/b",
+			TriageActions: 2,
+			Events: []domain.Contribution{
+				{Type: domain.ContributionTriage, Repo: "a/b", URL: "https://github.com/a/b/issues/3", Title: "Needs label", TriageAction: domain.TriageLabeled},
+				{Type: domain.ContributionTriage, Repo: "a/b", URL: "https://github.com/a/b/issues/4", Title: "Dupe", TriageAction: domain.TriageMarkedAsDuplicate},
+			},
+		},
+	}
+
+	out, err := renderer.RenderSummary(context.Background(), domain.User{Username: "ray"}, domain.StatsView{TriageActions: 2}, time.Now(), projects, nil, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content := string(out)
+	assertContains(t, content, "- 🗂️ **2** Triage Actions")
+	assertContains(t, content, "- 🗂️ 2 triage action(s)")
+	if strings.Contains(content, "Needs label") {
+		t.Fatalf("expected individual triage actions not to be listed")
+	}
+}
+
+func TestRenderSummary_FlagsTrivialPRs(t *testing.T) {
+	renderer := Renderer{}
+	projects := []domain.RepoContribution{
+		{
+			Repo:       "a/b",
+			PRsOpened:  3,
+			TrivialPRs: 2,
+			Events: []domain.Contribution{
+				{Type: domain.ContributionPR, Repo: "a/b", URL: "https://github.com/a/b/pull/1", Title: "Fix typo", Trivial: []domain.TrivialReason{domain.TrivialDocsOnly, domain.TrivialTitle}},
+				{Type: domain.ContributionPR, Repo: "a/b", URL: "https://github.com/a/b/pull/2", Title: "Update README.md", Trivial: []domain.TrivialReason{domain.TrivialDocsOnly}},
+				{Type: domain.ContributionPR, Repo: "a/b", URL: "https://github.com/a/b/pull/3", Title: "Add parser"},
+			},
+		},
+	}
+
+	out, err := renderer.RenderSummary(context.Background(), domain.User{Username: "ray"}, domain.StatsView{PRsOpened: 3}, time.Now(), projects, nil, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content := string(out)
+	assertContains(t, content, "**[Fix typo](https://github.com/a/b/pull/1)**")
+	assertContains(t, content, "· 🪶 Trivial (docs-only, title)")
+	assertContains(t, content, "- 🪶 2 PR(s) flagged trivial and scored at a reduced base: docs-only ×2, title ×1")
+	if strings.Count(content, "🪶 Trivial") != 2 {
+		t.Errorf("expected only the flagged PRs to be marked, got:\n%s", content)
+	}
+}
+
 func assertContains(t *testing.T, content, expected string) {
 	t.Helper()
 	if !strings.Contains(content, expected) {
 		t.Fatalf("expected content to contain %q", expected)
 	}
 }
+
+func TestRenderSummary_IncludesMilestones(t *testing.T) {
+	renderer := Renderer{}
+	user := domain.User{Username: "ray"}
+	insights := domain.Insights{
+		Milestones: []domain.Milestone{
+			{Kind: domain.MilestoneFirstMergedPR, Repo: "a/b", URL: "https://github.com/a/b/pull/1", Title: "Fix bug", FirstTimer: true, AchievedAt: time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC)},
+			{Kind: domain.MilestoneFirstPopularRepo, Repo: "big/repo", URL: "https://github.com/big/repo/issues/3", Threshold: 10000, AchievedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Kind: domain.MilestoneNewReposThisYear, Count: 4, Year: 2025, AchievedAt: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	out, err := renderer.RenderSummary(context.Background(), user, domain.StatsView{}, time.Now(), nil, nil, insights)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content := string(out)
+	assertContains(t, content, "## Milestones")
+	assertContains(t, content, "First merged PR to **[a/b](https://github.com/a/b)**: [Fix bug](https://github.com/a/b/pull/1) (Mar 2, 2021) · 🌱 First-time contributor")
+	assertContains(t, content, "First contribution to a 10k★ repo: **[big/repo](https://github.com/big/repo/issues/3)**")
+	assertContains(t, content, "**4** new repo(s) contributed to in 2025")
+}
+
+func TestRenderSummary_IncludesTrend(t *testing.T) {
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	insights := domain.Insights{Trend: &domain.Trend{
+		Since:    now.Add(-7 * 24 * time.Hour),
+		Score:    34,
+		Stats:    domain.StatsView{PRsOpened: 2, StarsEarned: -1},
+		NewRepos: []string{"new/repo"},
+		Repos:    []domain.RepoDelta{{Repo: "a/b", Score: 20.5}, {Repo: "c/d", Score: -3}},
+	}}
+
+	out, err := Renderer{}.RenderSummary(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, now, nil, nil, insights)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	content := string(out)
+	assertContains(t, content, "## Trend")
+	assertContains(t, content, "- 📈 **+34.0** score this week, new repo: [new/repo](https://github.com/new/repo)")
+	assertContains(t, content, "- 🔀 +2 PRs · ⭐ -1 stars")
+	assertContains(t, content, "- Biggest changes: `a/b` +20.5 · `c/d` -3.0")
+	if strings.Contains(content, "Scoring weights changed") {
+		t.Errorf("expected no reweighting note when scoring is unchanged")
+	}
+
+	insights.Trend.Since = now.AddDate(0, -1, 0)
+	insights.Trend.Score = -5
+	insights.Trend.ScoringChanged = true
+	out, err = Renderer{}.RenderSummary(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, now, nil, nil, insights)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	content = string(out)
+	assertContains(t, content, "- 📉 **-5.0** score since Feb 15, 2026")
+	assertContains(t, content, "Scoring weights changed since then")
+}
+
+func TestRenderSummary_HeaderShowsWindow(t *testing.T) {
+	renderer := Renderer{Window: domain.TimeWindow{Since: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)}}
+
+	out, err := renderer.RenderSummary(context.Background(), domain.User{Username: "ray"}, domain.StatsView{}, time.Now(), nil, nil, domain.Insights{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	assertContains(t, string(out), "Range: Since 2025-03-01")
+}
+
+func TestImpactLine(t *testing.T) {
+	if got := impactLine("Total Impact", 12, 12); got != "- 📈 **12.0** Total Impact\n" {
+		t.Errorf("expected a single total, got %q", got)
+	}
+	if got := impactLine("Total Impact", 12, 28); got != "- 📈 **12.0** Total Impact (recent) · **28.0** lifetime\n" {
+		t.Errorf("expected recent and lifetime totals, got %q", got)
+	}
+}
@@ -0,0 +1,128 @@
+package summary
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// RenderRescoreDiff compares a footprint scored with its original weights
+// (before) against new ones (after): the weights that changed, both totals
+// and the repo rankings side by side.
+func (r Renderer) RenderRescoreDiff(ctx context.Context, user domain.User, generatedAt time.Time, before, after domain.ScoringConfig, beforeTotals, afterTotals domain.ScoreTotals, changes []domain.RankChange) ([]byte, error) {
+	_ = ctx
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# Rescore: @%s\n\n", user.Username)
+	fmt.Fprintf(&sb, "*Generated on %s · Range: %s*\n\n", generatedAt.Format("January 2, 2006"), r.Window)
+
+	sb.WriteString("## Weights\n\n")
+	changed, err := changedWeights(before, after)
+	if err != nil {
+		return nil, err
+	}
+	if len(changed) == 0 {
+		sb.WriteString("No weights changed.\n")
+	}
+	for _, line := range changed {
+		sb.WriteString(line)
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("| | Before | After |\n| --- | ---: | ---: |\n")
+	fmt.Fprintf(&sb, "| Total Impact | %.1f | %.1f |\n", beforeTotals.Recent, afterTotals.Recent)
+	if beforeTotals.Recent != beforeTotals.Lifetime || afterTotals.Recent != afterTotals.Lifetime {
+		fmt.Fprintf(&sb, "| Lifetime Impact | %.1f | %.1f |\n", beforeTotals.Lifetime, afterTotals.Lifetime)
+	}
+	fmt.Fprintf(&sb, "| Scoring Hash | `%s` | `%s` |\n\n", shortHash(before.Hash()), shortHash(after.Hash()))
+
+	sb.WriteString("## Repository Rankings\n\n")
+	sb.WriteString("| Repository | Before | After | Change |\n| --- | ---: | ---: | :---: |\n")
+	for _, c := range changes {
+		fmt.Fprintf(&sb, "| `%s` | %s | %s | %s |\n", c.Repo, formatRank(c.BeforeRank, c.BeforeScore), formatRank(c.AfterRank, c.AfterScore), formatMove(c))
+	}
+	sb.WriteString("\n")
+
+	return []byte(sb.String()), nil
+}
+
+// changedWeights lists each top-level config field whose value differs.
+func changedWeights(before, after domain.ScoringConfig) ([]string, error) {
+	fields := func(c domain.ScoringConfig) (map[string]json.RawMessage, error) {
+		data, err := json.Marshal(c)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling scoring config: %w", err)
+		}
+		var m map[string]json.RawMessage
+		return m, json.Unmarshal(data, &m)
+	}
+	b, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := slices.Collect(maps.Keys(b))
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	var lines []string
+	for _, k := range keys {
+		if !bytes.Equal(b[k], a[k]) {
+			lines = append(lines, fmt.Sprintf("- `%s`: `%s` → `%s`\n", k, orNone(b[k]), orNone(a[k])))
+		}
+	}
+	return lines, nil
+}
+
+func orNone(v json.RawMessage) string {
+	if len(v) == 0 {
+		return "none"
+	}
+	return string(v)
+}
+
+func shortHash(hash string) string {
+	hash = strings.TrimPrefix(hash, "sha256:")
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
+
+func formatRank(rank int, score float64) string {
+	if rank == 0 {
+		return "–"
+	}
+	return fmt.Sprintf("#%d · %.1f", rank, score)
+}
+
+func formatMove(c domain.RankChange) string {
+	switch {
+	case c.BeforeRank == 0:
+		return "new"
+	case c.AfterRank == 0:
+		return "dropped"
+	case c.Moved() > 0:
+		return fmt.Sprintf("▲ %d", c.Moved())
+	case c.Moved() < 0:
+		return fmt.Sprintf("▼ %d", -c.Moved())
+	default:
+		return "="
+	}
+}
@@ -0,0 +1,40 @@
+package summary
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+func TestRenderRescoreDiff(t *testing.T) {
+	before := domain.ScoringConfig{MergedPRBonus: 1.5, RepoMultiplierCap: 4}
+	after := domain.ScoringConfig{MergedPRBonus: 2, RepoMultiplierCap: 4}
+	changes := []domain.RankChange{
+		{Repo: "b/two", BeforeRank: 2, AfterRank: 1, BeforeScore: 20, AfterScore: 40},
+		{Repo: "a/one", BeforeRank: 1, AfterRank: 2, BeforeScore: 30, AfterScore: 30},
+		{Repo: "c/three", BeforeRank: 3, AfterRank: 3, BeforeScore: 1, AfterScore: 1},
+	}
+
+	out, err := Renderer{}.RenderRescoreDiff(context.Background(), domain.User{Username: "ray"}, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		before, after, domain.ScoreTotals{Recent: 51, Lifetime: 51}, domain.ScoreTotals{Recent: 71, Lifetime: 71}, changes)
+	if err != nil {
+		t.Fatalf("RenderRescoreDiff: %v", err)
+	}
+	content := string(out)
+
+	assertContains(t, content, "# Rescore: @ray")
+	assertContains(t, content, "- `mergedPRBonus`: `1.5` → `2`")
+	assertContains(t, content, "| Total Impact | 51.0 | 71.0 |")
+	assertContains(t, content, "| `b/two` | #2 · 20.0 | #1 · 40.0 | ▲ 1 |")
+	assertContains(t, content, "| `a/one` | #1 · 30.0 | #2 · 30.0 | ▼ 1 |")
+	assertContains(t, content, "| `c/three` | #3 · 1.0 | #3 · 1.0 | = |")
+	if strings.Contains(content, "repoMultiplierCap") {
+		t.Errorf("expected unchanged weights to be left out, got:\n%s", content)
+	}
+	if strings.Contains(content, "Lifetime Impact") {
+		t.Errorf("expected no lifetime row without recency weighting")
+	}
+}
@@ -0,0 +1,90 @@
+package scoring
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// DefaultConfig returns the built-in weights described in README.md.
+func DefaultConfig() domain.ScoringConfig {
+	return domain.ScoringConfig{
+		BaseScores:               maps.Clone(baseContributionScores),
+		AdvisoryScores:           maps.Clone(advisorySeverityScores),
+		MergedPRBonus:            MergedPRBonus,
+		OwnershipScore:           OwnershipScore,
+		RepoMultiplierCap:        RepoMultiplierCap,
+		PopularityModel:          domain.PopularityLog,
+		CommentDecayRate:         CommentDecayRate,
+		TriageDecayRate:          TriageDecayRate,
+		DecayStrategy:            domain.DecayHyperbolic,
+		DecayTypes:               slices.Clone(domain.DefaultDecayTypes),
+		DecayBudget:              DefaultDecayBudget,
+		DecayWindow:              domain.DecayMonth,
+		ReleasePopularityDamping: ReleasePopularityDamping,
+		PrivateCommitScore:       PrivateCommitScore,
+		ResolvedIssueScore:       ResolvedIssueScore,
+		ResolvedIssueMaxAgeYears: ResolvedIssueMaxAgeYears,
+		ReactionBonusCap:         DefaultReactionBonusCap,
+		TrivialPR: domain.TrivialPRConfig{
+			Multiplier:    TrivialPRMultiplier,
+			DocsPaths:     slices.Clone(trivialDocsPaths),
+			MaxLines:      TrivialPRMaxLines,
+			TitlePatterns: slices.Clone(trivialTitlePatterns),
+		},
+	}
+}
+
+// LoadConfig reads a JSON scoring config. Fields and map entries missing from
+// the file keep their defaults, so a file only needs the weights it changes.
+// Unknown fields are rejected to catch typos.
+func LoadConfig(path string) (domain.ScoringConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.ScoringConfig{}, fmt.Errorf("reading scoring config: %w", err)
+	}
+
+	config := DefaultConfig()
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return domain.ScoringConfig{}, fmt.Errorf("parsing scoring config %s: %w", path, err)
+	}
+	if err := ValidateConfig(config); err != nil {
+		return domain.ScoringConfig{}, fmt.Errorf("invalid scoring config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// ParseBaseScores parses a comma-separated list of TYPE=score pairs, e.g.
+// "REVIEW=6,PR=8". Types follow ContributionType and are case-insensitive.
+func ParseBaseScores(spec string) (map[domain.ContributionType]float64, error) {
+	scores := make(map[domain.ContributionType]float64)
+	if strings.TrimSpace(spec) == "" {
+		return scores, nil
+	}
+
+	for _, pair := range strings.Split(spec, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("base score %q: expected TYPE=score", pair)
+		}
+		t := domain.ContributionType(strings.ToUpper(strings.TrimSpace(name)))
+		if !slices.Contains(domain.ContributionTypes, t) {
+			return nil, fmt.Errorf("base score %q: unknown contribution type %q", pair, name)
+		}
+		score, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("base score %q: %w", pair, err)
+		}
+		scores[t] = score
+	}
+	return scores, nil
+}
@@ -2,10 +2,13 @@ package scoring
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/arayofcode/footprint/internal/domain"
 )
 
+// baseContributionScores are the default base scores per contribution type.
 var baseContributionScores = map[domain.ContributionType]float64{
 	domain.ContributionTypePR:                10.0,
 	domain.ContributionTypeIssue:             5.0,
@@ -14,22 +17,106 @@ var baseContributionScores = map[domain.ContributionType]float64{
 	domain.ContributionTypeReviewComment:     1.0,
 	domain.ContributionTypeDiscussion:        2.0,
 	domain.ContributionTypeDiscussionComment: 2.0,
+	domain.ContributionTypeRelease:           8.0,
+	domain.ContributionTypeTriage:            0.5,
 }
 
-func baseScore(event domain.ContributionEvent) float64 {
-	if score, ok := baseContributionScores[event.Type]; ok {
+// advisorySeverityScores replace the flat base score for security advisory
+// credits by default. Unknown severities score as LOW.
+var advisorySeverityScores = map[domain.AdvisorySeverity]float64{
+	domain.AdvisorySeverityCritical: 40.0,
+	domain.AdvisorySeverityHigh:     25.0,
+	domain.AdvisorySeverityMedium:   15.0,
+	domain.AdvisorySeverityLow:      8.0,
+}
+
+func (c *Calculator) baseScore(event domain.ContributionEvent) float64 {
+	if event.Type == domain.ContributionTypeSecurityAdvisory {
+		return c.advisoryScore(event.Advisory)
+	}
+	if score, ok := c.config.BaseScores[event.Type]; ok {
 		return score
 	}
 	fmt.Printf("Score not found for type: %s", event.Type)
 	return 0
 }
 
+func (c *Calculator) advisoryScore(advisory *domain.AdvisoryCredit) float64 {
+	if advisory != nil {
+		if score, ok := c.config.AdvisoryScores[advisory.Severity]; ok {
+			return score
+		}
+	}
+	return c.config.AdvisoryScores[domain.AdvisorySeverityLow]
+}
+
+// ScoreContribution scores a single event. Under the percentile popularity
+// model its repo ranks as if it were the only one; ScoreBatch ranks repos
+// against each other.
 func (c *Calculator) ScoreContribution(event domain.ContributionEvent) domain.ContributionEvent {
-	event.BaseScore = baseScore(event)
+	return c.scoreContribution(event, loneRepoPercentile)
+}
+
+func (c *Calculator) scoreContribution(event domain.ContributionEvent, percentile float64) domain.ContributionEvent {
+	breakdown := &domain.ScoreBreakdown{
+		Base:        c.baseScore(event),
+		MergedBonus: 1,
+		DecayFactor: 1,
+		Recency:     1,
+	}
+	base := breakdown.Base
+	if reasons := c.trivialReasons(event); len(reasons) > 0 {
+		event.Trivial = reasons
+		breakdown.Trivial = reasons
+		breakdown.TrivialFactor = c.config.TrivialPR.Multiplier
+		base *= breakdown.TrivialFactor
+	}
 	// Add merged bonus for created PRs
 	if event.Type == domain.ContributionTypePR && event.Merged {
-		event.BaseScore *= MergedPRBonus
+		breakdown.MergedBonus = c.config.MergedPRBonus
+		if c.config.ResolvesIssuesBonus {
+			breakdown.ResolvedIssues = c.resolvedIssuesBonus(event)
+		}
 	}
-	event.PopularityRaw = event.PopularityMultiplier()
+	breakdown.Reactions = c.reactionBonus(event)
+	event.BaseScore = base*breakdown.MergedBonus + breakdown.ResolvedIssues + breakdown.Reactions
+	event.BaseScore, breakdown.Rules = c.applyRules(event, event.BaseScore)
+
+	event.PopularityRaw = c.popularity(event.PopularityMultiplier(), event.Dependents, event.Downloads, percentile)
+	if event.Type == domain.ContributionTypeRelease {
+		event.PopularityRaw = 1 + c.config.ReleasePopularityDamping*(event.PopularityRaw-1)
+	}
+	breakdown.Popularity = event.PopularityRaw
+	event.Breakdown = breakdown
 	return event
 }
+
+// resolvedIssuesBonus rewards PRs that close issues people cared about.
+// Each issue earns ResolvedIssueScore, scaled by 1 + log10(1 + reactions) and by
+// up to 2x for issues that had been open for ResolvedIssueMaxAgeYears or longer.
+func (c *Calculator) resolvedIssuesBonus(event domain.ContributionEvent) float64 {
+	const year = 365 * 24 * time.Hour
+	maxAge := c.config.ResolvedIssueMaxAgeYears
+
+	bonus := 0.0
+	for _, issue := range event.ResolvedIssues {
+		reactions := 1 + math.Log10(1+float64(issue.ReactionsCount))
+		ageYears := math.Min(float64(issue.OpenFor(event.CreatedAt))/float64(year), maxAge)
+		age := 1 + ageYears/maxAge
+		bonus += c.config.ResolvedIssueScore * reactions * age
+	}
+	return bonus
+}
+
+// reactionBonus weighs each reaction type by its configured weight,
+// clamped to [0, ReactionBonusCap].
+func (c *Calculator) reactionBonus(event domain.ContributionEvent) float64 {
+	if len(c.config.ReactionWeights) == 0 {
+		return 0
+	}
+	bonus := 0.0
+	for content, count := range event.Reactions {
+		bonus += c.config.ReactionWeights[content] * float64(count)
+	}
+	return math.Max(0, math.Min(bonus, c.config.ReactionBonusCap))
+}
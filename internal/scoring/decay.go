@@ -0,0 +1,85 @@
+package scoring
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// decayGroup is the set of events that count towards each other's decay.
+type decayGroup struct {
+	repo   string
+	typ    domain.ContributionType // Empty with DecayAcrossTypes
+	window string                  // Set under DecayWindowed, e.g. "2025-03"
+}
+
+// decayTracker applies the configured decay strategy to events fed to it in
+// chronological order.
+type decayTracker struct {
+	config domain.ScoringConfig
+	counts map[decayGroup]int
+	spent  map[decayGroup]float64 // Base score used up under DecayBudgeted
+}
+
+func newDecayTracker(config domain.ScoringConfig) *decayTracker {
+	return &decayTracker{
+		config: config,
+		counts: make(map[decayGroup]int),
+		spent:  make(map[decayGroup]float64),
+	}
+}
+
+// next returns how many earlier events share the event's decay group and
+// the factor its base score is scaled by.
+func (d *decayTracker) next(event domain.ContributionEvent) (int, float64) {
+	group := decayGroup{repo: event.Repo}
+	if !d.config.DecayAcrossTypes {
+		group.typ = event.Type
+	}
+	strategy := d.config.Strategy()
+	if strategy == domain.DecayWindowed {
+		group.window = windowKey(event.CreatedAt, d.config.Window())
+	}
+
+	count := d.counts[group]
+	d.counts[group]++
+
+	rate := d.config.CommentDecayRate
+	if event.Type == domain.ContributionTypeTriage {
+		rate = d.config.TriageDecayRate
+	}
+	switch strategy {
+	case domain.DecayExponential:
+		return count, math.Exp(-rate * float64(count))
+	case domain.DecayBudgeted:
+		if event.BaseScore <= 0 {
+			return count, 1
+		}
+		remaining := max(d.config.DecayBudget-d.spent[group], 0)
+		factor := min(remaining/event.BaseScore, 1)
+		d.spent[group] += event.BaseScore * factor
+		return count, factor
+	default:
+		// With default rates: 1, 0.66, 0.5, 0.4... for comments and
+		// 1, 0.33, 0.2, 0.14... for triage
+		return count, 1.0 / (1.0 + rate*float64(count))
+	}
+}
+
+// windowKey names the calendar window t falls in, in UTC.
+func windowKey(t time.Time, window domain.DecayWindow) string {
+	t = t.UTC()
+	switch window {
+	case domain.DecayWeek:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case domain.DecayQuarter:
+		return fmt.Sprintf("%d-Q%d", t.Year(), (int(t.Month())+2)/3)
+	case domain.DecayYear:
+		return fmt.Sprintf("%d", t.Year())
+	default:
+		return t.Format("2006-01")
+	}
+}
@@ -0,0 +1,56 @@
+package scoring
+
+import (
+	"math"
+	"sort"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// loneRepoPercentile is the percentile of a repo scored on its own, as the
+// middle of a set of one.
+const loneRepoPercentile = 0.5
+
+// popularity is a repo's raw popularity multiplier under the configured
+// model. logRaw is the log model's value and percentile the repo's rank
+// among the repos being scored, in [0, 1].
+func (c *Calculator) popularity(logRaw float64, dependents, downloads int, percentile float64) float64 {
+	switch c.config.Model() {
+	case domain.PopularityFlat:
+		return 1
+	case domain.PopularityPercentile:
+		return 1 + (c.config.MultiplierCap()-1)*percentile
+	case domain.PopularityDependents:
+		if dependents > 0 || downloads > 0 {
+			return 1 + math.Log10(1+float64(dependents)+float64(downloads)/1000)
+		}
+	}
+	return logRaw
+}
+
+// repoWeight is the size percentile ranks compare: stars + 2 × forks, as in
+// the log model.
+func repoWeight(stars, forks int) float64 {
+	return float64(stars) + 2*float64(forks)
+}
+
+// percentileRanks gives each repo its mid-rank percentile: the share of
+// repos that are smaller, plus half the share of the same size (itself
+// included). The smallest of many repos is near 0, the largest near 1, and
+// a repo on its own 0.5.
+func percentileRanks(weights map[string]float64) map[string]float64 {
+	sorted := make([]float64, 0, len(weights))
+	for _, w := range weights {
+		sorted = append(sorted, w)
+	}
+	sort.Float64s(sorted)
+
+	n := float64(len(sorted))
+	ranks := make(map[string]float64, len(weights))
+	for repo, w := range weights {
+		below := sort.SearchFloat64s(sorted, w)
+		same := sort.SearchFloat64s(sorted, math.Nextafter(w, math.Inf(1))) - below
+		ranks[repo] = (float64(below) + float64(same)/2) / n
+	}
+	return ranks
+}
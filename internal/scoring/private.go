@@ -0,0 +1,21 @@
+package scoring
+
+import (
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// PrivateCommitScore is the default per-commit score for private contributions. Public
+// commits aren't scored individually, so this stays deliberately small.
+const PrivateCommitScore = 1.0
+
+// ScorePrivate scores aggregate private activity with the public base scores.
+// Private repos have no visible popularity, so no multiplier, merged bonus or
+// decay applies. Restricted contributions have no known type and count as commits.
+func (c *Calculator) ScorePrivate(private domain.PrivateContributions) domain.PrivateContributions {
+	scores := c.config.BaseScores
+	private.Score = float64(private.PullRequests)*scores[domain.ContributionTypePR] +
+		float64(private.Issues)*scores[domain.ContributionTypeIssue] +
+		float64(private.Reviews)*scores[domain.ContributionTypeReview] +
+		float64(private.Commits+private.Restricted)*c.config.PrivateCommitScore
+	return private
+}
@@ -4,10 +4,32 @@ import (
 	"github.com/arayofcode/footprint/internal/domain"
 )
 
+// EnrichOwnedProject scores a single owned project. Under the percentile
+// popularity model it ranks as if it were the only one.
 func (c *Calculator) EnrichOwnedProject(project domain.OwnedProject) domain.EnrichedProject {
+	return c.enrichOwnedProject(project, loneRepoPercentile)
+}
+
+// EnrichOwnedProjects scores owned projects, ranking them against each
+// other under the percentile popularity model.
+func (c *Calculator) EnrichOwnedProjects(projects []domain.OwnedProject) []domain.EnrichedProject {
+	weights := make(map[string]float64, len(projects))
+	for _, p := range projects {
+		weights[p.Repo] = repoWeight(p.Stars, p.Forks)
+	}
+	percentiles := percentileRanks(weights)
+
+	enriched := make([]domain.EnrichedProject, 0, len(projects))
+	for _, p := range projects {
+		enriched = append(enriched, c.enrichOwnedProject(p, percentiles[p.Repo]))
+	}
+	return enriched
+}
+
+func (c *Calculator) enrichOwnedProject(project domain.OwnedProject, percentile float64) domain.EnrichedProject {
 	return domain.EnrichedProject{
 		OwnedProject:  project,
-		BaseScore:     OwnershipScore,
-		PopularityRaw: project.PopularityMultiplier(),
+		BaseScore:     c.config.OwnershipScore,
+		PopularityRaw: c.popularity(project.PopularityMultiplier(), project.Dependents, project.Downloads, percentile),
 	}
 }
@@ -0,0 +1,37 @@
+package scoring
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// ParseReactionWeights parses a comma-separated list of CONTENT=weight pairs,
+// e.g. "THUMBS_UP=0.5,HEART=1,ROCKET=1". Content names follow GitHub's
+// ReactionContent enum and are case-insensitive.
+func ParseReactionWeights(spec string) (map[domain.ReactionContent]float64, error) {
+	weights := make(map[domain.ReactionContent]float64)
+	if strings.TrimSpace(spec) == "" {
+		return weights, nil
+	}
+
+	for _, pair := range strings.Split(spec, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("reaction weight %q: expected CONTENT=weight", pair)
+		}
+		content := domain.ReactionContent(strings.ToUpper(strings.TrimSpace(name)))
+		if !slices.Contains(domain.ReactionContents, content) {
+			return nil, fmt.Errorf("reaction weight %q: unknown reaction %q", pair, name)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("reaction weight %q: %w", pair, err)
+		}
+		weights[content] = weight
+	}
+	return weights, nil
+}
@@ -0,0 +1,78 @@
+package scoring
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/arayofcode/footprint/internal/domain"
+	"github.com/arayofcode/footprint/internal/expr"
+)
+
+// ruleEnv is what a rule's when expression can read about an event.
+var ruleEnv = expr.Env{
+	"type":      expr.String, // ContributionType, e.g. "PR" or "REVIEW"
+	"repo":      expr.String, // owner/name
+	"stars":     expr.Number,
+	"forks":     expr.Number,
+	"merged":    expr.Bool,
+	"reactions": expr.Number, // Total across all reaction types
+	"labels":    expr.StringList,
+	"date":      expr.Date, // When the event was created
+}
+
+type rule struct {
+	domain.ScoringRule
+	when *expr.Program
+}
+
+// compileRules compiles every rule, reporting each one that fails.
+func compileRules(rules []domain.ScoringRule) ([]rule, error) {
+	compiled := make([]rule, 0, len(rules))
+	var errs []error
+	for i, r := range rules {
+		program, err := expr.Compile(r.When, ruleEnv)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rules[%d] %q: %w", i, r.Label(), err))
+			continue
+		}
+		compiled = append(compiled, rule{ScoringRule: r, when: program})
+	}
+	return compiled, errors.Join(errs...)
+}
+
+// ValidateConfig is domain.ScoringConfig.Validate plus a compile check of
+// every rule's when expression.
+func ValidateConfig(config domain.ScoringConfig) error {
+	_, err := compileRules(config.Rules)
+	return errors.Join(config.Validate(), err)
+}
+
+// applyRules runs score through every rule that matches event, in order.
+func (c *Calculator) applyRules(event domain.ContributionEvent, score float64) (float64, []domain.AppliedRule) {
+	if len(c.rules) == 0 {
+		return score, nil
+	}
+	vars := expr.Vars{
+		"type":      string(event.Type),
+		"repo":      event.Repo,
+		"stars":     float64(event.Stars),
+		"forks":     float64(event.Forks),
+		"merged":    event.Merged,
+		"reactions": float64(event.ReactionsCount),
+		"labels":    event.Labels,
+		"date":      event.CreatedAt,
+	}
+
+	var applied []domain.AppliedRule
+	for _, r := range c.rules {
+		if !r.when.Eval(vars) {
+			continue
+		}
+		if r.Multiply != 0 {
+			score *= r.Multiply
+		}
+		score += r.Add
+		applied = append(applied, domain.AppliedRule{Rule: r.Label(), Multiply: r.Multiply, Add: r.Add})
+	}
+	return score, applied
+}
@@ -1,61 +1,120 @@
 package scoring
 
 import (
-	"sort"
+	"cmp"
+	"math"
+	"regexp"
+	"slices"
+	"time"
 
 	"github.com/arayofcode/footprint/internal/domain"
 )
 
+// Default weights. A ScoringConfig can override each of them.
 const (
 	MergedPRBonus  = 1.5
 	OwnershipScore = 2500.0
+
+	// RepoMultiplierCap bounds the popularity multiplier of a repo.
+	RepoMultiplierCap = 4.0
+
+	// ResolvedIssueScore is the base bonus per issue closed by a merged PR,
+	// before weighting by the issue's reactions and age.
+	ResolvedIssueScore = 2.0
+	// ResolvedIssueMaxAgeYears caps how much an issue's age can boost its bonus.
+	ResolvedIssueMaxAgeYears = 2.0
+
+	// ReleasePopularityDamping scales the log popularity term for releases.
+	// Cutting a release is similar work whatever the repo's size, so
+	// popularity counts for half as much as it does for code contributions.
+	ReleasePopularityDamping = 0.5
+
+	// CommentDecayRate and TriageDecayRate set how fast repeated contributions
+	// of a decayable type lose value within a repo: 1 / (1 + rate * count).
+	CommentDecayRate = 0.5
+	TriageDecayRate  = 2.0
+
+	// DefaultDecayBudget is the base score each decay group can earn under
+	// the budget strategy: ten issue comments' worth.
+	DefaultDecayBudget = 20.0
+
+	// DefaultReactionBonusCap bounds the reaction bonus so a single viral
+	// comment can't outweigh a merged PR.
+	DefaultReactionBonusCap = 10.0
 )
 
 type Calculator struct {
+	config        domain.ScoringConfig
+	rules         []rule
+	titlePatterns []*regexp.Regexp // Compiled TrivialPR.TitlePatterns
+	now           func() time.Time // Reference time for recency weighting
 }
 
-func NewCalculator() *Calculator {
-	return &Calculator{}
+// NewCalculator scores with config, which should already have passed
+// ValidateConfig. Rules that fail to compile are skipped.
+func NewCalculator(config domain.ScoringConfig) *Calculator {
+	rules, _ := compileRules(config.Rules)
+	return &Calculator{
+		config:        config,
+		rules:         rules,
+		titlePatterns: compileTitlePatterns(config.TrivialPR.TitlePatterns),
+		now:           time.Now,
+	}
 }
 
-func (c *Calculator) ScoreBatch(events []domain.ContributionEvent) []domain.ContributionEvent {
-	// Map to track counts: repo -> type -> count
-	counts := make(map[string]map[domain.ContributionType]int)
+// Config returns the weights the calculator scores with.
+func (c *Calculator) Config() domain.ScoringConfig {
+	return c.config
+}
 
+// ScoreBatch scores events together, so that repeated contributions decay
+// and repos rank against each other. Events are scored oldest first; ties
+// are broken by URL, ID and type so the result never depends on input order.
+func (c *Calculator) ScoreBatch(events []domain.ContributionEvent) []domain.ContributionEvent {
 	scored := make([]domain.ContributionEvent, len(events))
 	copy(scored, events)
 
-	sort.Slice(scored, func(i, j int) bool {
-		if scored[i].CreatedAt.Equal(scored[j].CreatedAt) {
-			return scored[i].URL < scored[j].URL
-		}
-		return scored[i].CreatedAt.Before(scored[j].CreatedAt)
+	slices.SortStableFunc(scored, func(a, b domain.ContributionEvent) int {
+		return cmp.Or(
+			a.CreatedAt.Compare(b.CreatedAt),
+			cmp.Compare(a.URL, b.URL),
+			cmp.Compare(a.ID, b.ID),
+			cmp.Compare(a.Type, b.Type),
+		)
 	})
 
-	for i := range scored {
-		repo := scored[i].Repo
-		if _, ok := counts[repo]; !ok {
-			counts[repo] = make(map[domain.ContributionType]int)
-		}
-
-		// Increment count for this type in this repo
-		count := counts[repo][scored[i].Type]
-		counts[repo][scored[i].Type]++
+	weights := make(map[string]float64)
+	for _, e := range scored {
+		weights[e.Repo] = repoWeight(e.Stars, e.Forks)
+	}
+	percentiles := percentileRanks(weights)
 
+	decay := newDecayTracker(c.config)
+	asOf := c.now()
+	for i := range scored {
 		// Standard score calculation
-		scored[i] = c.ScoreContribution(scored[i])
+		scored[i] = c.scoreContribution(scored[i], percentiles[scored[i].Repo])
+
+		if c.config.Decays(scored[i].Type) {
+			count, factor := decay.next(scored[i])
+			scored[i].BaseScore *= factor
+			scored[i].Breakdown.DecayIndex = count
+			scored[i].Breakdown.DecayFactor = factor
+		}
 
-		if isDecayable(scored[i].Type) {
-			decay := 1.0 / (1.0 + 0.5*float64(count)) // 1, 0.66, 0.5, 0.4...
-			scored[i].BaseScore *= decay
+		// Recency is recorded rather than applied so lifetime scores survive;
+		// aggregation applies it.
+		if c.config.RecencyHalfLifeDays > 0 {
+			scored[i].RecencyFactor = c.recencyFactor(scored[i].CreatedAt, asOf)
+			scored[i].Breakdown.Recency = scored[i].RecencyFactor
 		}
 	}
 	return scored
 }
 
-func isDecayable(t domain.ContributionType) bool {
-	return t == domain.ContributionTypeIssueComment ||
-		t == domain.ContributionTypeReviewComment ||
-		t == domain.ContributionTypePRComment ||
-		t == domain.ContributionTypeDiscussionComment
+// recencyFactor is 0.5^(age / half-life): 1 for an event created at asOf,
+// 0.5 one half-life earlier. Events dated after asOf count in full.
+func (c *Calculator) recencyFactor(createdAt, asOf time.Time) float64 {
+	ageDays := max(asOf.Sub(createdAt), 0).Hours() / 24
+	return math.Exp2(-ageDays / c.config.RecencyHalfLifeDays)
 }
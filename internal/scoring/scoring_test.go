@@ -1,14 +1,21 @@
 package scoring
 
 import (
+	"maps"
 	"math"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/arayofcode/footprint/internal/domain"
 )
 
 func TestScoreContribution_UsesBaseScoreAndPopularity(t *testing.T) {
-	calculator := NewCalculator()
+	calculator := NewCalculator(DefaultConfig())
 	event := domain.ContributionEvent{
 		Type:  domain.ContributionTypePR,
 		Stars: 100,
@@ -25,7 +32,7 @@ func TestScoreContribution_UsesBaseScoreAndPopularity(t *testing.T) {
 }
 
 func TestScoreContribution_MergedPRGetsBonus(t *testing.T) {
-	calculator := NewCalculator()
+	calculator := NewCalculator(DefaultConfig())
 
 	merged := domain.ContributionEvent{
 		Type:   domain.ContributionTypePR,
@@ -57,7 +64,7 @@ func TestScoreContribution_MergedPRGetsBonus(t *testing.T) {
 }
 
 func TestEnrichOwnedProject_PopulatesBaseAndPopularity(t *testing.T) {
-	calculator := NewCalculator()
+	calculator := NewCalculator(DefaultConfig())
 	project := domain.OwnedProject{
 		Stars: 100,
 		Forks: 50,
@@ -72,7 +79,7 @@ func TestEnrichOwnedProject_PopulatesBaseAndPopularity(t *testing.T) {
 }
 
 func TestScoreBatch_AppliesDecay(t *testing.T) {
-	calculator := NewCalculator()
+	calculator := NewCalculator(DefaultConfig())
 	repo := "org/repo"
 
 	// Create multiple comments in the same repo
@@ -94,6 +101,520 @@ func TestScoreBatch_AppliesDecay(t *testing.T) {
 	assertFloatApprox(t, 1.0, scored[2].BaseScore, 1e-9)
 }
 
+func TestScoreBatch_DecayStrategies(t *testing.T) {
+	day := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+	comments := []domain.ContributionEvent{
+		{Type: domain.ContributionTypeIssueComment, Repo: "a/b", URL: "1", CreatedAt: day(1, 5)},
+		{Type: domain.ContributionTypeIssueComment, Repo: "a/b", URL: "2", CreatedAt: day(1, 20)},
+		{Type: domain.ContributionTypeIssueComment, Repo: "a/b", URL: "3", CreatedAt: day(2, 3)},
+	}
+
+	tests := []struct {
+		name   string
+		adjust func(*domain.ScoringConfig)
+		want   []float64 // Base 2 per comment
+	}{
+		{"hyperbolic", func(c *domain.ScoringConfig) {}, []float64{2, 2 / 1.5, 1}},
+		{"exponential", func(c *domain.ScoringConfig) { c.DecayStrategy = domain.DecayExponential }, []float64{2, 2 * math.Exp(-0.5), 2 * math.Exp(-1)}},
+		{"budget", func(c *domain.ScoringConfig) { c.DecayStrategy, c.DecayBudget = domain.DecayBudgeted, 3 }, []float64{2, 1, 0}},
+		{"monthly window", func(c *domain.ScoringConfig) { c.DecayStrategy = domain.DecayWindowed }, []float64{2, 2 / 1.5, 2}},
+		{"yearly window", func(c *domain.ScoringConfig) {
+			c.DecayStrategy, c.DecayWindow = domain.DecayWindowed, domain.DecayYear
+		}, []float64{2, 2 / 1.5, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			tt.adjust(&config)
+			scored := NewCalculator(config).ScoreBatch(comments)
+			for i, want := range tt.want {
+				assertFloatApprox(t, want, scored[i].BaseScore, 1e-9)
+			}
+		})
+	}
+}
+
+func TestScoreBatch_DecaysConfiguredTypes(t *testing.T) {
+	events := []domain.ContributionEvent{
+		{Type: domain.ContributionTypeIssue, Repo: "a/b", URL: "1"},
+		{Type: domain.ContributionTypeIssue, Repo: "a/b", URL: "2"},
+		{Type: domain.ContributionTypeReview, Repo: "a/b", URL: "3"},
+	}
+
+	scored := NewCalculator(DefaultConfig()).ScoreBatch(events)
+	assertFloatApprox(t, 5, scored[1].BaseScore, 1e-9) // Issues don't decay by default
+
+	config := DefaultConfig()
+	config.DecayTypes = []domain.ContributionType{domain.ContributionTypeIssue, domain.ContributionTypeReview}
+	scored = NewCalculator(config).ScoreBatch(events)
+	assertFloatApprox(t, 5/1.5, scored[1].BaseScore, 1e-9)
+	assertFloatApprox(t, 3, scored[2].BaseScore, 1e-9) // First review
+
+	config.DecayAcrossTypes = true
+	scored = NewCalculator(config).ScoreBatch(events)
+	assertFloatApprox(t, 3/2.0, scored[2].BaseScore, 1e-9) // Third in the repo
+	if scored[2].Breakdown.DecayIndex != 2 {
+		t.Errorf("expected decay index 2 across types, got %d", scored[2].Breakdown.DecayIndex)
+	}
+}
+
+func TestScoreBatch_DeterministicWithEqualTimestamps(t *testing.T) {
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	events := []domain.ContributionEvent{
+		{ID: "c", Type: domain.ContributionTypeIssueComment, Repo: "a/b", URL: "https://github.com/a/b/issues/1", CreatedAt: at},
+		{ID: "a", Type: domain.ContributionTypeIssueComment, Repo: "a/b", URL: "https://github.com/a/b/issues/1", CreatedAt: at},
+		{ID: "b", Type: domain.ContributionTypeReviewComment, Repo: "a/b", URL: "https://github.com/a/b/pull/2", CreatedAt: at},
+		{ID: "d", Type: domain.ContributionTypeIssueComment, Repo: "a/b", URL: "https://github.com/a/b/issues/0", CreatedAt: at},
+	}
+	config := DefaultConfig()
+	config.DecayAcrossTypes = true
+	calc := NewCalculator(config)
+
+	scoresByID := func(events []domain.ContributionEvent) map[string]float64 {
+		scores := make(map[string]float64)
+		for _, e := range calc.ScoreBatch(events) {
+			scores[e.ID] = e.BaseScore
+		}
+		return scores
+	}
+	want := scoresByID(events)
+	for range 20 {
+		shuffled := slices.Clone(events)
+		rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		if got := scoresByID(shuffled); !maps.Equal(got, want) {
+			t.Fatalf("expected the same scores for any input order, got %v and %v", got, want)
+		}
+	}
+	// Ties are broken by URL, then ID
+	assertFloatApprox(t, 2, want["d"], 1e-9)
+	assertFloatApprox(t, 2/1.5, want["a"], 1e-9)
+	assertFloatApprox(t, 1, want["c"], 1e-9)
+}
+
+func TestScoreBatch_RecordsRecencyFactor(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	events := []domain.ContributionEvent{
+		{ID: "new", Type: domain.ContributionTypePR, CreatedAt: now},
+		{ID: "old", Type: domain.ContributionTypePR, CreatedAt: now.AddDate(0, 0, -365)},
+	}
+
+	scored := NewCalculator(DefaultConfig()).ScoreBatch(events)
+	if scored[0].RecencyFactor != 0 || scored[1].RecencyFactor != 0 {
+		t.Fatalf("expected no recency factor by default, got %+v", scored)
+	}
+
+	config := DefaultConfig()
+	config.RecencyHalfLifeDays = 365
+	calculator := NewCalculator(config)
+	calculator.now = func() time.Time { return now }
+
+	scored = calculator.ScoreBatch(events)
+	// Sorted oldest first; one half-life old halves
+	assertFloatApprox(t, 0.5, scored[0].RecencyFactor, 1e-9)
+	assertFloatApprox(t, 1.0, scored[1].RecencyFactor, 1e-9)
+	// The base score itself is left for aggregation to weight
+	assertFloatApprox(t, 10.0, scored[0].BaseScore, 1e-9)
+}
+
+func TestScoreBatch_TriageDecaysHeavily(t *testing.T) {
+	calculator := NewCalculator(DefaultConfig())
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []domain.ContributionEvent{
+		{Type: domain.ContributionTypeTriage, Repo: "org/repo", URL: "u1", CreatedAt: base},
+		{Type: domain.ContributionTypeTriage, Repo: "org/repo", URL: "u2", CreatedAt: base.Add(time.Hour)},
+		{Type: domain.ContributionTypeTriage, Repo: "org/repo", URL: "u3", CreatedAt: base.Add(2 * time.Hour)},
+		{Type: domain.ContributionTypeTriage, Repo: "other/repo", URL: "u4", CreatedAt: base.Add(3 * time.Hour)},
+	}
+
+	scored := calculator.ScoreBatch(events)
+
+	// Base 0.5, decay 1 / (1 + 2*count) per repo
+	assertFloatApprox(t, 0.5, scored[0].BaseScore, 1e-9)
+	assertFloatApprox(t, 0.5/3, scored[1].BaseScore, 1e-9)
+	assertFloatApprox(t, 0.5/5, scored[2].BaseScore, 1e-9)
+	assertFloatApprox(t, 0.5, scored[3].BaseScore, 1e-9)
+}
+
+func TestScoreContribution_ResolvedIssuesBonus(t *testing.T) {
+	prCreated := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	event := domain.ContributionEvent{
+		Type:      domain.ContributionTypePR,
+		Merged:    true,
+		CreatedAt: prCreated,
+		ResolvedIssues: []domain.LinkedIssue{
+			// Fresh issue, no reactions: 2.0 * 1 * 1 = 2.0
+			{CreatedAt: prCreated},
+			// 99 reactions, open 3 years (capped at 2): 2.0 * 3 * 2 = 12.0
+			{CreatedAt: prCreated.AddDate(-3, 0, 0), ReactionsCount: 99},
+		},
+	}
+
+	disabled := NewCalculator(DefaultConfig()).ScoreContribution(event)
+	assertFloatApprox(t, 15.0, disabled.BaseScore, 1e-9)
+
+	config := DefaultConfig()
+	config.ResolvesIssuesBonus = true
+	calculator := NewCalculator(config)
+	enabled := calculator.ScoreContribution(event)
+	assertFloatApprox(t, 15.0+2.0+12.0, enabled.BaseScore, 1e-9)
+
+	// Unmerged PRs haven't closed anything yet
+	event.Merged = false
+	unmerged := calculator.ScoreContribution(event)
+	assertFloatApprox(t, 10.0, unmerged.BaseScore, 1e-9)
+}
+
+func TestScoreContribution_ReactionWeights(t *testing.T) {
+	event := domain.ContributionEvent{
+		Type: domain.ContributionTypeIssue,
+		Reactions: map[domain.ReactionContent]int{
+			domain.ReactionThumbsUp: 4,
+			domain.ReactionRocket:   2,
+			domain.ReactionEyes:     10,
+		},
+	}
+
+	// Reactions don't affect score by default
+	assertFloatApprox(t, 5.0, NewCalculator(DefaultConfig()).ScoreContribution(event).BaseScore, 1e-9)
+
+	config := DefaultConfig()
+	config.ReactionWeights = map[domain.ReactionContent]float64{
+		domain.ReactionThumbsUp: 0.5,
+		domain.ReactionRocket:   1.0,
+	}
+	// 5 + (4*0.5 + 2*1.0) = 9
+	assertFloatApprox(t, 9.0, NewCalculator(config).ScoreContribution(event).BaseScore, 1e-9)
+
+	config.ReactionBonusCap = 1.5
+	assertFloatApprox(t, 6.5, NewCalculator(config).ScoreContribution(event).BaseScore, 1e-9)
+}
+
+func TestParseReactionWeights(t *testing.T) {
+	weights, err := ParseReactionWeights("thumbs_up=0.5, HEART=1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if weights[domain.ReactionThumbsUp] != 0.5 || weights[domain.ReactionHeart] != 1 {
+		t.Fatalf("unexpected weights: %+v", weights)
+	}
+
+	for _, bad := range []string{"THUMBS_UP", "CLAP=1", "HEART=lots"} {
+		if _, err := ParseReactionWeights(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestScoreContribution_SecurityAdvisoryWeightedBySeverity(t *testing.T) {
+	calculator := NewCalculator(DefaultConfig())
+	tests := []struct {
+		severity domain.AdvisorySeverity
+		want     float64
+	}{
+		{domain.AdvisorySeverityCritical, 40.0},
+		{domain.AdvisorySeverityHigh, 25.0},
+		{domain.AdvisorySeverityMedium, 15.0},
+		{domain.AdvisorySeverityLow, 8.0},
+		{"", 8.0},
+	}
+
+	for _, tt := range tests {
+		event := domain.ContributionEvent{
+			Type:     domain.ContributionTypeSecurityAdvisory,
+			Advisory: &domain.AdvisoryCredit{GHSAID: "GHSA-xxxx", Severity: tt.severity},
+		}
+		scored := calculator.ScoreContribution(event)
+		assertFloatApprox(t, tt.want, scored.BaseScore, 1e-9)
+	}
+}
+
+func TestScoreContribution_ReleaseDampensPopularity(t *testing.T) {
+	calculator := NewCalculator(DefaultConfig())
+	event := domain.ContributionEvent{Type: domain.ContributionTypeRelease, Stars: 999}
+
+	scored := calculator.ScoreContribution(event)
+
+	assertFloatApprox(t, 8.0, scored.BaseScore, 1e-9)
+	// Full multiplier would be 1 + log10(1000) = 4
+	assertFloatApprox(t, 2.5, scored.PopularityRaw, 1e-9)
+}
+
+func TestScorePrivate_UsesBaseScoresWithoutPopularity(t *testing.T) {
+	private := domain.PrivateContributions{Restricted: 4, Commits: 6, Issues: 2, PullRequests: 3, Reviews: 5}
+
+	scored := NewCalculator(DefaultConfig()).ScorePrivate(private)
+
+	// 3*10 + 2*5 + 5*3 + (6+4)*1 = 65
+	assertFloatApprox(t, 65.0, scored.Score, 1e-9)
+	if scored.Total() != private.Total() {
+		t.Fatalf("expected counts to be preserved, got %+v", scored)
+	}
+}
+
+func TestScoreBatch_RecordsBreakdown(t *testing.T) {
+	now := time.Now()
+	events := []domain.ContributionEvent{
+		{ID: "pr", Type: domain.ContributionTypePR, Repo: "ext/repo", Merged: true, CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: "c1", Type: domain.ContributionTypeIssueComment, Repo: "ext/repo", CreatedAt: now.Add(-time.Hour)},
+		{ID: "c2", Type: domain.ContributionTypeIssueComment, Repo: "ext/repo", CreatedAt: now},
+	}
+
+	scored := NewCalculator(DefaultConfig()).ScoreBatch(events)
+
+	pr := scored[0].Breakdown
+	if pr == nil {
+		t.Fatalf("expected a breakdown on every scored event")
+	}
+	assertFloatApprox(t, 10.0, pr.Base, 1e-9)
+	assertFloatApprox(t, MergedPRBonus, pr.MergedBonus, 1e-9)
+	assertFloatApprox(t, 1.0, pr.DecayFactor, 1e-9)
+	assertFloatApprox(t, 1.0, pr.Recency, 1e-9)
+
+	second := scored[2].Breakdown
+	if second.DecayIndex != 1 {
+		t.Errorf("expected decay index 1 for the second comment, got %d", second.DecayIndex)
+	}
+	assertFloatApprox(t, 1/(1+CommentDecayRate), second.DecayFactor, 1e-9)
+	// The breakdown multiplies back out to the event's base score
+	assertFloatApprox(t, scored[2].BaseScore, second.Base*second.MergedBonus*second.DecayFactor, 1e-9)
+}
+
+func TestScoreContribution_AppliesRules(t *testing.T) {
+	config := DefaultConfig()
+	config.Rules = []domain.ScoringRule{
+		{Name: "security", When: `type == "PR" && "security" in labels`, Multiply: 2},
+		{When: `type == "REVIEW" && stars > 5000`, Add: 5},
+	}
+	calculator := NewCalculator(config)
+
+	pr := calculator.ScoreContribution(domain.ContributionEvent{Type: domain.ContributionTypePR, Merged: true, Labels: []string{"security"}})
+	// 10 * 1.5 merged, then * 2
+	assertFloatApprox(t, 30.0, pr.BaseScore, 1e-9)
+	if len(pr.Breakdown.Rules) != 1 || pr.Breakdown.Rules[0] != (domain.AppliedRule{Rule: "security", Multiply: 2}) {
+		t.Errorf("expected the security rule in the breakdown, got %+v", pr.Breakdown.Rules)
+	}
+
+	review := calculator.ScoreContribution(domain.ContributionEvent{Type: domain.ContributionTypeReview, Stars: 6000})
+	assertFloatApprox(t, 8.0, review.BaseScore, 1e-9)
+	if len(review.Breakdown.Rules) != 1 || review.Breakdown.Rules[0].Rule != config.Rules[1].When {
+		t.Errorf("expected unnamed rules to be labeled by their expression, got %+v", review.Breakdown.Rules)
+	}
+
+	small := calculator.ScoreContribution(domain.ContributionEvent{Type: domain.ContributionTypeReview, Stars: 10})
+	assertFloatApprox(t, 3.0, small.BaseScore, 1e-9)
+	if small.Breakdown.Rules != nil {
+		t.Errorf("expected no rules to match, got %+v", small.Breakdown.Rules)
+	}
+}
+
+func TestValidateConfig_ReportsRuleErrors(t *testing.T) {
+	config := DefaultConfig()
+	config.Rules = []domain.ScoringRule{{Name: "typo", When: `star > 5`, Add: 1}}
+	err := ValidateConfig(config)
+	if err == nil || !strings.Contains(err.Error(), `rules[0] "typo": col 1: unknown variable "star"`) {
+		t.Errorf("expected a located rule error, got %v", err)
+	}
+}
+
+func TestPopularityModels(t *testing.T) {
+	event := domain.ContributionEvent{Type: domain.ContributionTypePR, Repo: "a/b", Stars: 99}
+	withMetrics := event
+	withMetrics.Dependents, withMetrics.Downloads = 9, 90000
+
+	tests := []struct {
+		model domain.PopularityModel
+		event domain.ContributionEvent
+		want  float64
+	}{
+		{domain.PopularityLog, event, 3},
+		{domain.PopularityFlat, event, 1},
+		{domain.PopularityDependents, withMetrics, 3}, // 1 + log10(1 + 9 + 90)
+		{domain.PopularityDependents, event, 3},       // No data: falls back to log
+		{domain.PopularityPercentile, event, 2.5},     // Alone: mid-rank 0.5 up to the cap of 4
+	}
+	for _, tt := range tests {
+		config := DefaultConfig()
+		config.PopularityModel = tt.model
+		scored := NewCalculator(config).ScoreContribution(tt.event)
+		assertFloatApprox(t, tt.want, scored.PopularityRaw, 1e-9)
+	}
+}
+
+func TestScoreContribution_UsesStarsAtEvent(t *testing.T) {
+	then := 9
+	event := domain.ContributionEvent{Type: domain.ContributionTypePR, Repo: "a/b", Stars: 999, StarsAtEvent: &then}
+
+	scored := NewCalculator(DefaultConfig()).ScoreContribution(event)
+	assertFloatApprox(t, 2, scored.PopularityRaw, 1e-9) // 1 + log10(1 + 9)
+}
+
+func TestScoreContribution_FlagsTrivialPRs(t *testing.T) {
+	config := DefaultConfig()
+	config.TrivialPR.Enabled = true
+	calc := NewCalculator(config)
+
+	pr := func(title string, lines int, files ...string) domain.ContributionEvent {
+		return domain.ContributionEvent{
+			Type: domain.ContributionTypePR, Repo: "a/b", Title: title, Merged: true,
+			Additions: lines, ChangedFiles: len(files), Files: files,
+		}
+	}
+	tests := []struct {
+		name  string
+		event domain.ContributionEvent
+		want  []domain.TrivialReason
+	}{
+		{"code", pr("Add retries", 40, "client.go", "client_test.go"), nil},
+		{"readme", pr("Clarify install steps", 12, "README.md"), []domain.TrivialReason{domain.TrivialDocsOnly}},
+		{"docs dir", pr("Document retries", 30, "docs/guide/retries.html", "CHANGELOG.md"), []domain.TrivialReason{domain.TrivialDocsOnly}},
+		{"one word", pr("Rename variable", 2, "main.go"), []domain.TrivialReason{domain.TrivialSmall}},
+		{"typo", pr("Fix Typo in error message", 6, "errors.go"), []domain.TrivialReason{domain.TrivialTitle}},
+		{"all", pr("fix typos", 2, "README.md"), domain.TrivialReasons},
+		{"no file data", pr("Add retries", 0), nil},
+		{"files truncated", domain.ContributionEvent{Type: domain.ContributionTypePR, Title: "Docs", Merged: true, Additions: 900, ChangedFiles: 80, Files: []string{"README.md"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scored := calc.ScoreContribution(tt.event)
+			if !slices.Equal(scored.Trivial, tt.want) {
+				t.Fatalf("expected reasons %v, got %v", tt.want, scored.Trivial)
+			}
+			want := 15.0 // 10 base × 1.5 merged
+			if len(tt.want) > 0 {
+				want *= TrivialPRMultiplier
+			}
+			assertFloatApprox(t, want, scored.BaseScore, 1e-9)
+		})
+	}
+
+	disabled := NewCalculator(DefaultConfig()).ScoreContribution(pr("fix typos", 2, "README.md"))
+	if len(disabled.Trivial) != 0 {
+		t.Errorf("expected no flags with detection disabled, got %v", disabled.Trivial)
+	}
+}
+
+func TestScoreBatch_RanksPercentilePopularity(t *testing.T) {
+	config := DefaultConfig()
+	config.PopularityModel = domain.PopularityPercentile
+	config.PopularityCaps = map[domain.PopularityModel]float64{domain.PopularityPercentile: 3}
+
+	scored := NewCalculator(config).ScoreBatch([]domain.ContributionEvent{
+		{Type: domain.ContributionTypePR, Repo: "small", Stars: 5, URL: "1"},
+		{Type: domain.ContributionTypePR, Repo: "large", Stars: 500, URL: "2"},
+	})
+	// Mid-ranks 0.25 and 0.75 of a cap of 3
+	assertFloatApprox(t, 1.5, scored[0].PopularityRaw, 1e-9)
+	assertFloatApprox(t, 2.5, scored[1].PopularityRaw, 1e-9)
+	if config.MultiplierCap() != 3 {
+		t.Errorf("expected the percentile cap to override repoMultiplierCap, got %v", config.MultiplierCap())
+	}
+}
+
+func TestEnrichOwnedProjects_RanksPercentilePopularity(t *testing.T) {
+	config := DefaultConfig()
+	config.PopularityModel = domain.PopularityPercentile
+
+	enriched := NewCalculator(config).EnrichOwnedProjects([]domain.OwnedProject{
+		{Repo: "me/a", Stars: 10},
+		{Repo: "me/b", Stars: 10},
+		{Repo: "me/c", Stars: 1000},
+		{Repo: "me/d", Stars: 1},
+	})
+	// Ties share a mid-rank: d 0.125, a and b 0.5, c 0.875
+	assertFloatApprox(t, 2.5, enriched[0].PopularityRaw, 1e-9)
+	assertFloatApprox(t, 2.5, enriched[1].PopularityRaw, 1e-9)
+	assertFloatApprox(t, 3.625, enriched[2].PopularityRaw, 1e-9)
+	assertFloatApprox(t, 1.375, enriched[3].PopularityRaw, 1e-9)
+}
+
+func TestDefaultConfig_IsValid(t *testing.T) {
+	if err := DefaultConfig().Validate(); err != nil {
+		t.Fatalf("expected default config to be valid, got %v", err)
+	}
+	if DefaultConfig().Hash() != DefaultConfig().Hash() {
+		t.Fatalf("expected equal configs to hash the same")
+	}
+}
+
+func TestCalculator_UsesConfiguredWeights(t *testing.T) {
+	config := DefaultConfig()
+	config.BaseScores[domain.ContributionTypeReview] = 6
+	config.MergedPRBonus = 1
+
+	calculator := NewCalculator(config)
+
+	assertFloatApprox(t, 6.0, calculator.ScoreContribution(domain.ContributionEvent{Type: domain.ContributionTypeReview}).BaseScore, 1e-9)
+	assertFloatApprox(t, 10.0, calculator.ScoreContribution(domain.ContributionEvent{Type: domain.ContributionTypePR, Merged: true}).BaseScore, 1e-9)
+	if calculator.Config().Hash() == DefaultConfig().Hash() {
+		t.Fatalf("expected a changed config to hash differently")
+	}
+}
+
+func TestLoadConfig_OverridesDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scoring.json")
+	if err := os.WriteFile(path, []byte(`{"baseScores": {"REVIEW": 6}, "commentDecayRate": 1}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	assertFloatApprox(t, 6.0, config.BaseScores[domain.ContributionTypeReview], 1e-9)
+	assertFloatApprox(t, 10.0, config.BaseScores[domain.ContributionTypePR], 1e-9)
+	assertFloatApprox(t, 1.0, config.CommentDecayRate, 1e-9)
+	assertFloatApprox(t, TriageDecayRate, config.TriageDecayRate, 1e-9)
+}
+
+func TestLoadConfig_RejectsInvalidWeights(t *testing.T) {
+	tests := map[string]string{
+		"unknown field": `{"commentDecay": 1}`,
+		"unknown type":  `{"baseScores": {"COMMIT": 1}}`,
+		"negative":      `{"mergedPRBonus": -1}`,
+		"low cap":       `{"repoMultiplierCap": 0.5}`,
+		"rule type":     `{"rules": [{"when": "stars > \"5k\"", "add": 5}]}`,
+		"rule no-op":    `{"rules": [{"when": "merged"}]}`,
+		"model":         `{"popularityModel": "stars"}`,
+		"model cap":     `{"popularityCaps": {"flat": 0}}`,
+		"trivial":       `{"trivialPR": {"multiplier": 2}}`,
+		"trivial title": `{"trivialPR": {"titlePatterns": ["(typo"]}}`,
+		"decay":         `{"decayStrategy": "linear"}`,
+		"decay budget":  `{"decayStrategy": "budget", "decayBudget": 0}`,
+		"decay type":    `{"decayTypes": ["COMMIT"]}`,
+		"decay window":  `{"decayWindow": "day"}`,
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "scoring.json")
+			if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadConfig(path); err == nil {
+				t.Fatalf("expected %s to be rejected", body)
+			}
+		})
+	}
+}
+
+func TestParseBaseScores(t *testing.T) {
+	scores, err := ParseBaseScores("review=6, PR=8")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(scores) != 2 || scores[domain.ContributionTypeReview] != 6 || scores[domain.ContributionTypePR] != 8 {
+		t.Fatalf("unexpected scores: %+v", scores)
+	}
+
+	for _, spec := range []string{"PR", "COMMIT=1", "PR=lots"} {
+		if _, err := ParseBaseScores(spec); err == nil {
+			t.Fatalf("expected %q to be rejected", spec)
+		}
+	}
+	if _, err := ParseBaseScores("PR=lots"); !strings.Contains(err.Error(), "PR=lots") {
+		t.Fatalf("expected error to name the pair, got %v", err)
+	}
+}
+
 func assertFloatApprox(t *testing.T, expected, actual, tolerance float64) {
 	t.Helper()
 	if math.Abs(expected-actual) > tolerance {
@@ -0,0 +1,90 @@
+package scoring
+
+import (
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/arayofcode/footprint/internal/domain"
+)
+
+// Trivial PR defaults. Detection is off unless TrivialPRConfig.Enabled is set.
+const (
+	// TrivialPRMultiplier scales the base score of a trivial PR, so a typo
+	// fix to a popular repo is worth about as much as an issue comment.
+	TrivialPRMultiplier = 0.25
+	// TrivialPRMaxLines catches one-word edits: a changed line counts as
+	// one deletion plus one addition.
+	TrivialPRMaxLines = 2
+)
+
+// trivialDocsPaths are the documentation files a docs-only PR touches.
+var trivialDocsPaths = []string{
+	"*.md", "*.markdown", "*.rst", "*.adoc",
+	"LICENSE*", "AUTHORS*", "CONTRIBUTORS*", "CODE_OF_CONDUCT*",
+	"docs/", "doc/",
+}
+
+// trivialTitlePatterns match the titles of typo and wording fixes.
+var trivialTitlePatterns = []string{
+	`\btypos?\b`,
+	`\bspelling\b`,
+	`\bgrammar\b`,
+	`^update readme(\.md)?$`,
+}
+
+// compileTitlePatterns compiles each pattern case-insensitively, skipping
+// invalid ones, which ScoringConfig.Validate reports.
+func compileTitlePatterns(patterns []string) []*regexp.Regexp {
+	var compiled []*regexp.Regexp
+	for _, p := range patterns {
+		if re, err := regexp.Compile("(?i)" + p); err == nil {
+			compiled = append(compiled, re)
+		}
+	}
+	return compiled
+}
+
+// trivialReasons lists the signals that flag event as a trivial PR. File
+// signals need the PR's changed files; events fetched without them, like
+// older events.json dumps, are only judged by title.
+func (c *Calculator) trivialReasons(event domain.ContributionEvent) []domain.TrivialReason {
+	config := c.config.TrivialPR
+	if !config.Enabled || event.Type != domain.ContributionTypePR {
+		return nil
+	}
+
+	var reasons []domain.TrivialReason
+	if event.ChangedFiles > 0 {
+		if len(event.Files) == event.ChangedFiles && len(config.DocsPaths) > 0 &&
+			!slices.ContainsFunc(event.Files, func(f string) bool { return !isDocsPath(f, config.DocsPaths) }) {
+			reasons = append(reasons, domain.TrivialDocsOnly)
+		}
+		if config.MaxLines > 0 && event.Additions+event.Deletions <= config.MaxLines {
+			reasons = append(reasons, domain.TrivialSmall)
+		}
+	}
+	if slices.ContainsFunc(c.titlePatterns, func(re *regexp.Regexp) bool { return re.MatchString(event.Title) }) {
+		reasons = append(reasons, domain.TrivialTitle)
+	}
+	return reasons
+}
+
+// isDocsPath reports whether file matches a docs glob: by base name, or for
+// globs ending in "/", by any directory it is in.
+func isDocsPath(file string, globs []string) bool {
+	dirs := strings.Split(path.Dir(file), "/")
+	for _, glob := range globs {
+		if dir, ok := strings.CutSuffix(glob, "/"); ok {
+			if slices.ContainsFunc(dirs, func(d string) bool { ok, _ := path.Match(dir, d); return ok }) {
+				return true
+			}
+			continue
+		}
+		if ok, _ := path.Match(glob, path.Base(file)); ok {
+			return true
+		}
+	}
+	return false
+}